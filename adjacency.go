@@ -0,0 +1,188 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrUnknownAdjacency = errors.New("adjacência desconhecida")
+	ErrHexWrapOddRows   = errors.New("hex com bordas ligadas exige um número par de linhas")
+)
+
+// DEFAULT_ADJACENCY é a vizinhança de 8 células usada quando a matriz não define outra
+const DEFAULT_ADJACENCY = "king"
+
+// ADJACENCY_WRAP_SUFFIX liga as bordas opostas de qualquer topologia, ex: "orthogonal+wrap"
+const ADJACENCY_WRAP_SUFFIX = "+wrap"
+
+// Adjacency define quais células são vizinhas e como se anda em linha reta numa topologia
+type Adjacency interface {
+	Name() string
+	// Neighbors anexa a dst os vizinhos de from numa matriz rows x cols
+	Neighbors(dst []Coord, from Coord, rows, cols int) []Coord
+	// Lines são as direções de leitura em linha reta (busca simples e caça-palavras)
+	Lines() []Direction
+	// Step avança uma célula na direção; false se sair da matriz (com wrap, sempre true)
+	Step(from Coord, direction Direction, rows, cols int) (Coord, bool)
+}
+
+// gridAdjacency é uma topologia de deslocamentos fixos sobre a grade retangular.
+// Em hex, as linhas ímpares são deslocadas meia célula para a direita (odd-r) e os
+// deslocamentos diagonais são os das linhas pares.
+type gridAdjacency struct {
+	name    string
+	offsets []Direction
+	hex     bool
+	wrap    bool
+}
+
+var (
+	orthogonalOffsets = []Direction{
+		{R, "→", 0, 1},
+		{L, "←", 0, -1},
+		{B, "↓", 1, 0},
+		{T, "↑", -1, 0},
+	}
+	hexOffsets = []Direction{
+		{R, "→", 0, 1},
+		{L, "←", 0, -1},
+		{BR, "↘", 1, 0},
+		{BL, "↙", 1, -1},
+		{TR, "↗", -1, 0},
+		{TL, "↖", -1, -1},
+	}
+	knightOffsets = []Direction{
+		{"TTR", "♞", -2, 1},
+		{"TTL", "♞", -2, -1},
+		{"BBR", "♞", 2, 1},
+		{"BBL", "♞", 2, -1},
+		{"RRT", "♞", -1, 2},
+		{"RRB", "♞", 1, 2},
+		{"LLT", "♞", -1, -2},
+		{"LLB", "♞", 1, -2},
+	}
+)
+
+// adjacencies são as topologias disponíveis pelo nome (cabeçalho "adjacency:" ou -adjacency)
+var adjacencies = map[string]Adjacency{
+	"orthogonal": &gridAdjacency{name: "orthogonal", offsets: orthogonalOffsets},
+	"king":       &gridAdjacency{name: "king", offsets: lineDirections},
+	"hex":        &gridAdjacency{name: "hex", offsets: hexOffsets, hex: true},
+	"knight":     &gridAdjacency{name: "knight", offsets: knightOffsets},
+	"torus":      &gridAdjacency{name: "torus", offsets: lineDirections, wrap: true},
+}
+
+// adjacencyAliases são nomes alternativos aceitos por ParseAdjacency
+var adjacencyAliases = map[string]string{"4": "orthogonal", "8": "king", "": DEFAULT_ADJACENCY}
+
+// ParseAdjacency retorna a topologia registrada com o nome informado; o sufixo "+wrap" liga as bordas
+func ParseAdjacency(name string) (Adjacency, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name, wrap := strings.CutSuffix(name, ADJACENCY_WRAP_SUFFIX)
+	if alias, ok := adjacencyAliases[name]; ok {
+		name = alias
+	}
+	adjacency, ok := adjacencies[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (disponíveis: %s)", ErrUnknownAdjacency, name, strings.Join(AdjacencyNames(), ", "))
+	}
+	if wrap {
+		return WithWrap(adjacency), nil
+	}
+	return adjacency, nil
+}
+
+// WithWrap retorna a topologia com as bordas opostas ligadas (toro). Em hex, o número de
+// linhas deve ser par para que a paridade das linhas se mantenha ao atravessar a borda;
+// SetAdjacency recusa a combinação em matrizes com número ímpar de linhas.
+func WithWrap(adjacency Adjacency) Adjacency {
+	grid, ok := adjacency.(*gridAdjacency)
+	if !ok || grid.wrap {
+		return adjacency
+	}
+	wrapped := *grid
+	wrapped.name += ADJACENCY_WRAP_SUFFIX
+	wrapped.wrap = true
+	return &wrapped
+}
+
+// isHex indica se a topologia é a grade hexagonal, com ou sem wrap
+func isHex(adjacency Adjacency) bool {
+	grid, ok := adjacency.(*gridAdjacency)
+	return ok && grid.hex
+}
+
+// AdjacencyNames retorna os nomes das topologias em ordem alfabética
+func AdjacencyNames() []string {
+	names := make([]string, 0, len(adjacencies))
+	for name := range adjacencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (ga *gridAdjacency) Name() string {
+	return ga.name
+}
+
+func (ga *gridAdjacency) Lines() []Direction {
+	return ga.offsets
+}
+
+func (ga *gridAdjacency) Neighbors(dst []Coord, from Coord, rows, cols int) []Coord {
+	for _, offset := range ga.offsets {
+		if next, ok := ga.Step(from, offset, rows, cols); ok && next != from && !containsCoord(dst, next) {
+			dst = append(dst, next)
+		}
+	}
+	return dst
+}
+
+func (ga *gridAdjacency) Step(from Coord, direction Direction, rows, cols int) (Coord, bool) {
+	if ga.hex && direction.DeltaRow != 0 && from.X%2 != 0 {
+		direction.DeltaCol++
+	}
+	if ga.wrap {
+		row, col := from.X+direction.DeltaRow, from.Y+direction.DeltaCol
+		return Coord{X: (row%rows + rows) % rows, Y: (col%cols + cols) % cols}, true
+	}
+	next, err := from.next(direction, rows, cols)
+	return next, err == nil
+}
+
+// IsAdjacent indica se to é vizinha de from na topologia
+func IsAdjacent(adjacency Adjacency, from, to Coord, rows, cols int) bool {
+	var buffer [8]Coord
+	return containsCoord(adjacency.Neighbors(buffer[:0], from, rows, cols), to)
+}
+
+func containsCoord(coords []Coord, coord Coord) bool {
+	for _, c := range coords {
+		if c == coord {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAdjacency retorna a topologia da matriz (king se não definida)
+func (lm *LetterMatrix) GetAdjacency() Adjacency {
+	if lm.adjacency == nil {
+		return adjacencies[DEFAULT_ADJACENCY]
+	}
+	return lm.adjacency
+}
+
+// SetAdjacency define a topologia usada pelos buscadores nesta matriz.
+// Retorna ErrHexWrapOddRows para hex com wrap numa matriz com número ímpar de linhas.
+func (lm *LetterMatrix) SetAdjacency(adjacency Adjacency) error {
+	if grid, ok := adjacency.(*gridAdjacency); ok && grid.hex && grid.wrap && lm.rows%2 != 0 {
+		return fmt.Errorf("%w: %s em matriz de %d linhas", ErrHexWrapOddRows, grid.name, lm.rows)
+	}
+	lm.adjacency = adjacency
+	return nil
+}
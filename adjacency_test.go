@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+)
+
+// sortedNeighbors returns the neighbours of a cell ordered by row and column
+func sortedNeighbors(t *testing.T, name string, from Coord, rows, cols int) []Coord {
+	t.Helper()
+	adjacency, err := ParseAdjacency(name)
+	if err != nil {
+		t.Fatalf("Failed to parse adjacency %q: %v", name, err)
+	}
+	neighbors := adjacency.Neighbors(nil, from, rows, cols)
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].X != neighbors[j].X {
+			return neighbors[i].X < neighbors[j].X
+		}
+		return neighbors[i].Y < neighbors[j].Y
+	})
+	return neighbors
+}
+
+// TestAdjacencyNeighbors tests the neighbour set of each topology
+func TestAdjacencyNeighbors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		adjacency  string
+		from       Coord
+		rows, cols int
+		expected   []Coord
+	}{
+		{"orthogonal center", "orthogonal", Coord{1, 1}, 3, 3, []Coord{{0, 1}, {1, 0}, {1, 2}, {2, 1}}},
+		{"orthogonal corner", "4", Coord{0, 0}, 3, 3, []Coord{{0, 1}, {1, 0}}},
+		{"king corner", "king", Coord{0, 0}, 3, 3, []Coord{{0, 1}, {1, 0}, {1, 1}}},
+		{"king center", "8", Coord{1, 1}, 3, 3, []Coord{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 2}, {2, 0}, {2, 1}, {2, 2}}},
+		{"hex even row", "hex", Coord{2, 2}, 5, 5, []Coord{{1, 1}, {1, 2}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}},
+		{"hex odd row", "hex", Coord{1, 2}, 5, 5, []Coord{{0, 2}, {0, 3}, {1, 1}, {1, 3}, {2, 2}, {2, 3}}},
+		{"knight corner", "knight", Coord{0, 0}, 3, 3, []Coord{{1, 2}, {2, 1}}},
+		{"knight center", "knight", Coord{1, 1}, 3, 3, nil},
+		{"torus corner", "torus", Coord{0, 0}, 3, 3, []Coord{{0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}, {2, 2}}},
+		{"torus narrow", "torus", Coord{0, 0}, 1, 3, []Coord{{0, 1}, {0, 2}}},
+	}
+
+	for _, tc := range testCases {
+		got := sortedNeighbors(t, tc.adjacency, tc.from, tc.rows, tc.cols)
+		if len(got) != len(tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.expected[i] {
+				t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, got)
+				break
+			}
+		}
+	}
+}
+
+// TestParseAdjacencyErrors tests that unknown topologies are rejected by name and in the header
+func TestParseAdjacencyErrors(t *testing.T) {
+	if _, err := ParseAdjacency("spiral"); !errors.Is(err, ErrUnknownAdjacency) {
+		t.Errorf("Expected ErrUnknownAdjacency, got %v", err)
+	}
+	if _, err := parseBoard([]string{"---", "adjacency: spiral", "---", "abc"}); !errors.Is(err, ErrUnknownAdjacency) {
+		t.Errorf("Expected ErrUnknownAdjacency from header, got %v", err)
+	}
+}
+
+// TestAdjacencyPathSearch tests that the path walker follows the board topology
+func TestAdjacencyPathSearch(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	grid := "pxxxxx/xlxxxx/xxaxxx/xxxnxx/xxxxex/xxxxxt"
+
+	testCases := []struct {
+		adjacency string
+		expected  int
+	}{
+		{"king", 1},
+		{"orthogonal", 0},
+		{"knight", 0},
+	}
+	for _, tc := range testCases {
+		matrix, err := NewLetterMatrixFromGrid(grid)
+		if err != nil {
+			t.Fatalf("Failed to parse grid: %v", err)
+		}
+		adjacency, _ := ParseAdjacency(tc.adjacency)
+		if err := matrix.SetAdjacency(adjacency); err != nil {
+			t.Fatalf("%s: failed to set adjacency: %v", tc.adjacency, err)
+		}
+		found := NewPathSearcher(matrix, dict).SearchAllWords()
+		if len(found) != tc.expected {
+			t.Errorf("%s: expected %d results, got %v", tc.adjacency, tc.expected, found)
+		}
+	}
+}
+
+// TestAdjacencyHeaderHex tests that a hex header connects cells through the offset layout
+func TestAdjacencyHeaderHex(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	// A straight column zigzags between the two lower neighbours of each row
+	matrix, err := parseBoard([]string{"---", "adjacency: hex", "---", "xpx", "xlx", "xax", "xnx", "xex", "xtx"})
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	if matrix.GetAdjacency().Name() != "hex" {
+		t.Fatalf("Expected hex adjacency, got %s", matrix.GetAdjacency().Name())
+	}
+	found := NewPathSearcher(matrix, dict).SearchAllWords()
+	if len(found) != 1 || found[0].Path() != "(1,2)(2,2)(3,2)(4,2)(5,2)(6,2)" {
+		t.Errorf("Expected PLANET down the column, got %v", found)
+	}
+
+	// (1,1) and (2,2) touch on a square grid but not on an even row of the hex layout
+	matrix, err = parseBoard([]string{"---", "adjacency: hex", "---", "pxx", "xlx", "xax", "xnx", "xex", "xtx"})
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	if found := NewPathSearcher(matrix, dict).SearchAllWords(); len(found) != 0 {
+		t.Errorf("Expected no results through a square diagonal, got %v", found)
+	}
+}
+
+// TestAdjacencyTorusLineSearch tests that the line searcher wraps around a torus board
+func TestAdjacencyTorusLineSearch(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	board := []string{"---", "game: wordsearch", "adjacency: torus", "---", "netpla", "xxxxxx"}
+	matrix, err := parseBoard(board)
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	scorer, _ := NewScorer(DEFAULT_RULES)
+
+	var words []string
+	for result := range Solve(context.Background(), matrix, dict, scorer) {
+		words = append(words, result.Word+" "+result.Path())
+	}
+	if len(words) != 1 || words[0] != "PLANET (1,4)(1,5)(1,6)(1,1)(1,2)(1,3)" {
+		t.Errorf("Expected PLANET wrapping around the row, got %v", words)
+	}
+
+	board[2] = "adjacency: king"
+	matrix, _ = parseBoard(board)
+	for result := range Solve(context.Background(), matrix, dict, scorer) {
+		t.Errorf("Expected no words without wrap, got %s", result.Word)
+	}
+}
+
+// TestAdjacencyNeighborsEveryCell tests every cell of a small board against the distance rule of each topology
+func TestAdjacencyNeighborsEveryCell(t *testing.T) {
+	rules := map[string]func(dr, dc int) bool{
+		"orthogonal": func(dr, dc int) bool { return dr*dr+dc*dc == 1 },
+		"king":       func(dr, dc int) bool { return max(dr*dr, dc*dc) == 1 },
+		"knight":     func(dr, dc int) bool { return dr*dr+dc*dc == 5 },
+	}
+	const rows, cols = 4, 5
+	for name, adjacent := range rules {
+		for row := range rows {
+			for col := range cols {
+				from := Coord{X: row, Y: col}
+				var expected []Coord
+				for r := range rows {
+					for c := range cols {
+						if adjacent(r-row, c-col) {
+							expected = append(expected, Coord{X: r, Y: c})
+						}
+					}
+				}
+				got := sortedNeighbors(t, name, from, rows, cols)
+				if len(got) != len(expected) {
+					t.Errorf("%s %v: expected %v, got %v", name, from, expected, got)
+					continue
+				}
+				for i := range got {
+					if got[i] != expected[i] {
+						t.Errorf("%s %v: expected %v, got %v", name, from, expected, got)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestParseAdjacencyWrap tests the +wrap suffix on every topology
+func TestParseAdjacencyWrap(t *testing.T) {
+	for _, name := range []string{"orthogonal", "king", "hex", "knight"} {
+		adjacency, err := ParseAdjacency(name + "+wrap")
+		if err != nil {
+			t.Fatalf("Failed to parse %s+wrap: %v", name, err)
+		}
+		if adjacency.Name() != name+"+wrap" {
+			t.Errorf("Expected %s+wrap, got %s", name, adjacency.Name())
+		}
+	}
+	torus, _ := ParseAdjacency("torus")
+	if WithWrap(torus) != torus {
+		t.Errorf("Expected torus to be returned unchanged")
+	}
+	got := sortedNeighbors(t, "orthogonal+wrap", Coord{0, 0}, 3, 3)
+	expected := []Coord{{0, 1}, {0, 2}, {1, 0}, {2, 0}}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+	for i := range got {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, got)
+			break
+		}
+	}
+}
+
+// TestWrapSearchBothEngines tests that words continue across the border in both engines
+func TestWrapSearchBothEngines(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	scorer, _ := NewScorer(DEFAULT_RULES)
+
+	for _, game := range []string{GameFree, GameWordSearch} {
+		board := []string{"---", "game: " + game, "adjacency: orthogonal+wrap", "---", "anetpl", "xxxxxx"}
+		matrix, err := parseBoard(board)
+		if err != nil {
+			t.Fatalf("Failed to parse board: %v", err)
+		}
+		var words []string
+		for result := range Solve(context.Background(), matrix, dict, scorer) {
+			words = append(words, result.Word+" "+result.Path())
+		}
+		if len(words) != 1 || words[0] != "PLANET (1,5)(1,6)(1,1)(1,2)(1,3)(1,4)" {
+			t.Errorf("%s: expected PLANET across the border, got %v", game, words)
+		}
+
+		board[2] = "adjacency: orthogonal"
+		matrix, _ = parseBoard(board)
+		for result := range Solve(context.Background(), matrix, dict, scorer) {
+			t.Errorf("%s: expected no words without wrap, got %s", game, result.Word)
+		}
+	}
+}
+
+// TestWrapNoCellReuse tests that a word cannot reuse a cell after wrapping all the way round
+func TestWrapNoCellReuse(t *testing.T) {
+	dict := loadTestDictionary(t, "PLAPLA", "PLANET")
+	scorer, _ := NewScorer(DEFAULT_RULES)
+
+	for _, game := range []string{GameFree, GameWordSearch} {
+		matrix, err := parseBoard([]string{"---", "game: " + game, "adjacency: torus", "---", "pla"})
+		if err != nil {
+			t.Fatalf("Failed to parse board: %v", err)
+		}
+		for result := range Solve(context.Background(), matrix, dict, scorer) {
+			t.Errorf("%s: expected no words reusing cells, got %s %s", game, result.Word, result.Path())
+		}
+	}
+
+	// Also on the targeted word list search
+	matrix, _ := parseBoard([]string{"---", "game: wordsearch", "adjacency: torus", "---", "pla"})
+	results := NewWordSimpleSearcher(matrix, dict).FindTargets([]string{"plapla"})
+	if len(results) != 1 || results[0].Status == TargetFound {
+		t.Errorf("Expected PLAPLA not to be found, got %+v", results)
+	}
+}
+
+// TestHexWrapOddRows tests that hex with wrap is refused on boards with an odd number of rows
+func TestHexWrapOddRows(t *testing.T) {
+	if _, err := parseBoard([]string{"---", "adjacency: hex+wrap", "---", "abc", "def", "ghi"}); !errors.Is(err, ErrHexWrapOddRows) {
+		t.Errorf("Expected ErrHexWrapOddRows, got %v", err)
+	}
+	if _, err := parseBoard([]string{"---", "adjacency: hex+wrap", "---", "abc", "def"}); err != nil {
+		t.Errorf("Expected hex+wrap on an even board, got %v", err)
+	}
+	if _, err := parseBoard([]string{"---", "adjacency: king+wrap", "---", "abc", "def", "ghi"}); err != nil {
+		t.Errorf("Expected king+wrap on an odd board, got %v", err)
+	}
+}
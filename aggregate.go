@@ -0,0 +1,121 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrUnknownAggregate = errors.New("modo de agregação desconhecido")
+
+// AggregateMode define como os caminhos de uma mesma palavra são agrupados
+type AggregateMode int
+
+const (
+	// AggregateAll mantém todos os caminhos
+	AggregateAll AggregateMode = iota
+	// AggregateUnique mantém só as palavras, uma vez cada
+	AggregateUnique
+	// AggregateBest mantém o caminho de maior pontuação de cada palavra
+	AggregateBest
+	// AggregateSpecials mantém o caminho que toca mais células especiais de cada palavra
+	AggregateSpecials
+)
+
+// DEFAULT_AGGREGATE preserva a saída original com todos os caminhos
+const DEFAULT_AGGREGATE = "all"
+
+var aggregateNames = map[string]AggregateMode{
+	"all":      AggregateAll,
+	"unique":   AggregateUnique,
+	"best":     AggregateBest,
+	"specials": AggregateSpecials,
+}
+
+// ParseAggregateMode converte o nome da flag -aggregate
+func ParseAggregateMode(name string) (AggregateMode, error) {
+	mode, ok := aggregateNames[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q (disponíveis: all, unique, best, specials)", ErrUnknownAggregate, name)
+	}
+	return mode, nil
+}
+
+func (mode AggregateMode) String() string {
+	for name, value := range aggregateNames {
+		if value == mode {
+			return name
+		}
+	}
+	return fmt.Sprintf("AggregateMode(%d)", int(mode))
+}
+
+// AggregatedResult é um caminho representante e quantos caminhos distintos formam a mesma palavra
+type AggregatedResult struct {
+	PathResult
+	Paths int
+}
+
+// format mostra a palavra e, fora do modo unique, o caminho; xN indica caminhos alternativos
+func (ar AggregatedResult) format(mode AggregateMode) string {
+	text := ar.PathResult.String()
+	if mode == AggregateUnique {
+		text = ar.Word
+	}
+	if ar.Paths > 1 && mode != AggregateAll {
+		text += fmt.Sprintf(" x%d", ar.Paths)
+	}
+	return text
+}
+
+// Aggregate agrupa os caminhos por palavra segundo o modo; o resultado segue a ordem de sortPathResults.
+// Os caminhos são deduplicados, então resultados repetidos de pontos de partida diferentes contam uma vez.
+func Aggregate(results []PathResult, mode AggregateMode, matrix *LetterMatrix) []AggregatedResult {
+	unique := make(map[string]PathResult, len(results))
+	for _, result := range results {
+		unique[result.String()] = result
+	}
+	paths := make([]PathResult, 0, len(unique))
+	counts := make(map[string]int)
+	for _, result := range unique {
+		paths = append(paths, result)
+		counts[result.Word]++
+	}
+	sortPathResults(paths)
+
+	if mode == AggregateAll {
+		aggregated := make([]AggregatedResult, len(paths))
+		for i, result := range paths {
+			aggregated[i] = AggregatedResult{PathResult: result, Paths: counts[result.Word]}
+		}
+		return aggregated
+	}
+
+	// Com os caminhos ordenados, o primeiro de cada palavra é o de maior pontuação
+	best := make(map[string]PathResult, len(counts))
+	order := make([]string, 0, len(counts))
+	for _, result := range paths {
+		current, seen := best[result.Word]
+		if !seen {
+			order = append(order, result.Word)
+			best[result.Word] = result
+			continue
+		}
+		if mode == AggregateSpecials && matrix != nil &&
+			matrix.CountSpecials(result.Coordinates) > matrix.CountSpecials(current.Coordinates) {
+			best[result.Word] = result
+		}
+	}
+
+	aggregated := make([]AggregatedResult, len(order))
+	for i, word := range order {
+		aggregated[i] = AggregatedResult{PathResult: best[word], Paths: counts[word]}
+	}
+	if mode == AggregateSpecials && matrix != nil {
+		sort.SliceStable(aggregated, func(i, j int) bool {
+			return matrix.CountSpecials(aggregated[i].Coordinates) > matrix.CountSpecials(aggregated[j].Coordinates)
+		})
+	}
+	return aggregated
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestAggregate tests grouping paths by word in every mode
+func TestAggregate(t *testing.T) {
+	matrix, err := NewLetterMatrixFromString("abcdEf\nghijkl")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	plain := PathResult{Word: "PLANET", Coordinates: []Coord{{1, 0}, {1, 1}}, Score: Score{Total: 10}}
+	special := PathResult{Word: "PLANET", Coordinates: []Coord{{0, 4}, {0, 5}}, Score: Score{Total: 8}}
+	other := PathResult{Word: "PLATEN", Coordinates: []Coord{{1, 2}, {1, 3}}, Score: Score{Total: 9}}
+	// The same path found again from another start must count once
+	results := []PathResult{special, plain, other, plain}
+
+	testCases := []struct {
+		mode     AggregateMode
+		expected []string
+		paths    []int
+	}{
+		{AggregateAll, []string{plain.String(), other.String(), special.String()}, []int{2, 1, 2}},
+		{AggregateUnique, []string{plain.String(), other.String()}, []int{2, 1}},
+		{AggregateBest, []string{plain.String(), other.String()}, []int{2, 1}},
+		{AggregateSpecials, []string{special.String(), other.String()}, []int{2, 1}},
+	}
+
+	for _, tc := range testCases {
+		aggregated := Aggregate(results, tc.mode, matrix)
+		if len(aggregated) != len(tc.expected) {
+			t.Errorf("%s: expected %d results, got %v", tc.mode, len(tc.expected), aggregated)
+			continue
+		}
+		for i := range aggregated {
+			if aggregated[i].String() != tc.expected[i] || aggregated[i].Paths != tc.paths[i] {
+				t.Errorf("%s: result %d expected %s x%d, got %s x%d", tc.mode, i,
+					tc.expected[i], tc.paths[i], aggregated[i], aggregated[i].Paths)
+			}
+		}
+	}
+
+	if got := Aggregate(results, AggregateUnique, matrix)[0].format(AggregateUnique); got != "PLANET x2" {
+		t.Errorf("Expected unique format %q, got %q", "PLANET x2", got)
+	}
+}
+
+// TestParseAggregateMode tests the -aggregate names
+func TestParseAggregateMode(t *testing.T) {
+	for name, expected := range map[string]AggregateMode{"all": AggregateAll, "UNIQUE": AggregateUnique, "best": AggregateBest, "specials": AggregateSpecials} {
+		if mode, err := ParseAggregateMode(name); err != nil || mode != expected {
+			t.Errorf("ParseAggregateMode(%q) = %v, %v", name, mode, err)
+		}
+	}
+	if _, err := ParseAggregateMode("first"); !errors.Is(err, ErrUnknownAggregate) {
+		t.Errorf("Expected ErrUnknownAggregate, got %v", err)
+	}
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrNoBoards = errors.New("nenhuma matriz encontrada")
+
+// BATCH_SUMMARY_FILE é o nome do resumo gravado no diretório de saída
+const BATCH_SUMMARY_FILE = "summary.txt"
+
+// BatchJob é uma matriz a resolver e o nome usado no arquivo de resultado
+type BatchJob struct {
+	Name string
+	Path string
+}
+
+// BatchResult é o resultado de uma matriz do lote
+type BatchResult struct {
+	Job      BatchJob
+	Output   string
+	Words    int
+	Longest  string
+	Best     PathResult
+	Duration time.Duration
+	Err      error
+}
+
+// BatchSolver resolve muitas matrizes com um único dicionário e um número limitado de workers
+type BatchSolver struct {
+	dictionary *Dictionary
+	rules      string
+	// forceRules ignora as regras do cabeçalho das matrizes
+	forceRules bool
+	outDir     string
+	workers    int
+}
+
+// NewBatchSolver cria um resolvedor que grava os resultados em outDir (vazio não grava)
+func NewBatchSolver(dictionary *Dictionary, outDir string, workers int) *BatchSolver {
+	return &BatchSolver{
+		dictionary: dictionary,
+		rules:      DEFAULT_RULES,
+		outDir:     outDir,
+		workers:    max(workers, 1),
+	}
+}
+
+// SetRules define as regras de pontuação; com force, elas prevalecem sobre o cabeçalho da matriz
+func (bs *BatchSolver) SetRules(name string, force bool) error {
+	if _, err := NewScorer(name); err != nil {
+		return err
+	}
+	bs.rules = name
+	bs.forceRules = force
+	return nil
+}
+
+// Solve resolve as matrizes em paralelo; os resultados seguem a ordem dos jobs
+func (bs *BatchSolver) Solve(jobs []BatchJob) []BatchResult {
+	results := make([]BatchResult, len(jobs))
+	indexes := make(chan int, len(jobs))
+	for i := range jobs {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	for range min(bs.workers, len(jobs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = bs.solveOne(jobs[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// solveOne carrega, resolve e grava o resultado de uma matriz
+func (bs *BatchSolver) solveOne(job BatchJob) (result BatchResult) {
+	start := time.Now()
+	result.Job = job
+	defer func() { result.Duration = time.Since(start) }()
+
+	matrix, err := NewLetterMatrixFromFile(job.Path)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	rules := bs.rules
+	if meta := matrix.GetMeta(); meta.Rules != "" && !bs.forceRules {
+		rules = meta.Rules
+	}
+	scorer, err := NewScorer(rules)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	found := searchBoard(matrix, bs.dictionary, scorer)
+	sortPathResults(found)
+	words := make(map[string]bool, len(found))
+	for _, path := range found {
+		words[path.Word] = true
+		if len(path.Word) > len(result.Longest) || (len(path.Word) == len(result.Longest) && path.Word < result.Longest) {
+			result.Longest = path.Word
+		}
+	}
+	result.Words = len(words)
+	if len(found) > 0 {
+		result.Best = found[0]
+	}
+
+	if bs.outDir != "" {
+		result.Output = filepath.Join(bs.outDir, job.Name+".txt")
+		result.Err = writeBatchResult(result.Output, found)
+	}
+	return result
+}
+
+// searchBoard busca com o buscador indicado pelo jogo da matriz (linhas retas no caça-palavras)
+func searchBoard(matrix *LetterMatrix, dictionary *Dictionary, scorer Scorer) []PathResult {
+	found, _ := searchBoardContext(context.Background(), matrix, dictionary, scorer)
+	return found
+}
+
+func writeBatchResult(filename string, found []PathResult) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(file)
+	for _, result := range found {
+		fmt.Fprintf(writer, "%s [%d]\n", result, result.Score.Total)
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// WriteBatchSummary imprime uma linha por matriz e os totais do lote
+func WriteBatchSummary(w io.Writer, results []BatchResult, elapsed time.Duration) {
+	solved, words := 0, 0
+	var busy time.Duration
+	fmt.Fprintf(w, "%-24s %8s  %-20s %7s %10s\n", "MATRIZ", "PALAVRAS", "MAIS LONGA", "MELHOR", "TEMPO")
+	for _, result := range results {
+		busy += result.Duration
+		if result.Err != nil {
+			fmt.Fprintf(w, "%-24s erro: %v\n", result.Job.Name, result.Err)
+			continue
+		}
+		solved++
+		words += result.Words
+		fmt.Fprintf(w, "%-24s %8d  %-20s %7d %10s\n", result.Job.Name, result.Words, result.Longest,
+			result.Best.Score.Total, result.Duration.Round(time.Millisecond))
+	}
+	fmt.Fprintf(w, "\nMatrizes: %d  Resolvidas: %d  Com erro: %d  Palavras: %d\n",
+		len(results), solved, len(results)-solved, words)
+	fmt.Fprintf(w, "Tempo total: %s  Soma dos tempos: %s\n", elapsed.Round(time.Millisecond), busy.Round(time.Millisecond))
+}
+
+// collectBatchJobs lista as matrizes de um diretório (arquivos em ordem alfabética)
+// ou de um manifesto com um caminho por linha, relativo ao manifesto
+func collectBatchJobs(input string) ([]BatchJob, error) {
+	info, err := os.Stat(input)
+	if err != nil {
+		return nil, fmt.Errorf("%s do lote: %w", ErrFileOpen, err)
+	}
+
+	var paths []string
+	if info.IsDir() {
+		entries, err := os.ReadDir(input)
+		if err != nil {
+			return nil, fmt.Errorf("%s do lote: %w", ErrFileRead, err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+				paths = append(paths, filepath.Join(input, entry.Name()))
+			}
+		}
+		sort.Strings(paths)
+	} else {
+		lines, err := readTargets(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range lines {
+			if strings.HasPrefix(line, "#") {
+				continue
+			}
+			if !filepath.IsAbs(line) {
+				line = filepath.Join(filepath.Dir(input), line)
+			}
+			paths = append(paths, line)
+		}
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w em %s", ErrNoBoards, input)
+	}
+
+	// Nomes repetidos recebem um sufixo para não sobrescrever resultados
+	jobs := make([]BatchJob, len(paths))
+	used := make(map[string]int, len(paths))
+	for i, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if used[name]++; used[name] > 1 {
+			name = fmt.Sprintf("%s-%d", name, used[name])
+		}
+		jobs[i] = BatchJob{Name: name, Path: path}
+	}
+	return jobs, nil
+}
+
+// runBatch implementa o subcomando "wordgo batch <diretório|manifesto>"
+func runBatch(args []string) {
+	flags := flag.NewFlagSet("batch", flag.ExitOnError)
+	dictFile := flags.String("dict", DEFAULT_DICTIONARY, "Arquivo de dicionário compartilhado pelo lote")
+	rules := flags.String("rules", DEFAULT_RULES, "Regras de pontuação (o cabeçalho da matriz prevalece se a flag não for informada)")
+	output := flags.String("out", "results", "Diretório dos arquivos de resultado e do resumo")
+	workers := flags.Int("workers", runtime.NumCPU(), "Número de matrizes resolvidas em paralelo")
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		log.Fatal("Uso: wordgo batch [opções] <diretório|manifesto>")
+	}
+	jobs, err := collectBatchJobs(flags.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(*output, 0o755); err != nil {
+		log.Fatalf("Erro ao criar %s: %v", *output, err)
+	}
+
+	start := time.Now()
+	dict, err := NewDictionary(*dictFile)
+	if err != nil {
+		log.Fatalf("Erro ao carregar dicionário: %v", err)
+	}
+	fmt.Printf("Dicionário carregado em %s\n", time.Since(start).Round(time.Millisecond))
+
+	solver := NewBatchSolver(dict, *output, *workers)
+	explicit := false
+	flags.Visit(func(f *flag.Flag) { explicit = explicit || f.Name == "rules" })
+	if err := solver.SetRules(*rules, explicit); err != nil {
+		log.Fatal(err)
+	}
+	results := solver.Solve(jobs)
+
+	var summary strings.Builder
+	WriteBatchSummary(&summary, results, time.Since(start))
+	fmt.Print(summary.String())
+	summaryFile := filepath.Join(*output, BATCH_SUMMARY_FILE)
+	if err := os.WriteFile(summaryFile, []byte(summary.String()), 0o644); err != nil {
+		log.Fatalf("Erro ao gravar %s: %v", summaryFile, err)
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestBatchSolver tests solving a directory of boards with a shared dictionary
+func TestBatchSolver(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "STREAM")
+	input := t.TempDir()
+	output := t.TempDir()
+	boards := map[string]string{
+		"a.txt": "planet\nxxxxxx",
+		"b.txt": "---\ngame: wordsearch\n---\nxxxxxx\nmaerts",
+		"c.txt": "---\nformat: wordgo/2\n---\nabc",
+	}
+	for name, content := range boards {
+		if err := os.WriteFile(filepath.Join(input, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("Failed to write board: %v", err)
+		}
+	}
+
+	jobs, err := collectBatchJobs(input)
+	if err != nil {
+		t.Fatalf("collectBatchJobs failed: %v", err)
+	}
+	results := NewBatchSolver(dict, output, 2).Solve(jobs)
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	if results[0].Job.Name != "a" || results[0].Words != 1 || results[0].Longest != "PLANET" {
+		t.Errorf("Unexpected result for a: %+v", results[0])
+	}
+	if results[1].Longest != "STREAM" {
+		t.Errorf("Word search board should find STREAM backwards, got %+v", results[1])
+	}
+	if !errors.Is(results[2].Err, ErrUnsupportedFormat) {
+		t.Errorf("Expected ErrUnsupportedFormat for c, got %v", results[2].Err)
+	}
+
+	written, err := os.ReadFile(filepath.Join(output, "a.txt"))
+	if err != nil {
+		t.Fatalf("Result file not written: %v", err)
+	}
+	if !strings.HasPrefix(string(written), "PLANET (1,1)") {
+		t.Errorf("Unexpected result file:\n%s", written)
+	}
+
+	var summary strings.Builder
+	WriteBatchSummary(&summary, results, time.Second)
+	if !strings.Contains(summary.String(), "Matrizes: 3  Resolvidas: 2  Com erro: 1  Palavras: 2") {
+		t.Errorf("Unexpected summary:\n%s", summary.String())
+	}
+}
+
+// TestCollectBatchJobsManifest tests manifests with relative paths, comments and repeated names
+func TestCollectBatchJobsManifest(t *testing.T) {
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "daily.txt")
+	content := "# boards of the day\nboards/one.txt\n\nother/one.txt\n/abs/two.txt\n"
+	if err := os.WriteFile(manifest, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write manifest: %v", err)
+	}
+
+	jobs, err := collectBatchJobs(manifest)
+	if err != nil {
+		t.Fatalf("collectBatchJobs failed: %v", err)
+	}
+	expected := []BatchJob{
+		{Name: "one", Path: filepath.Join(dir, "boards/one.txt")},
+		{Name: "one-2", Path: filepath.Join(dir, "other/one.txt")},
+		{Name: "two", Path: "/abs/two.txt"},
+	}
+	if len(jobs) != len(expected) {
+		t.Fatalf("Expected %d jobs, got %v", len(expected), jobs)
+	}
+	for i := range expected {
+		if jobs[i] != expected[i] {
+			t.Errorf("Job %d: expected %+v, got %+v", i, expected[i], jobs[i])
+		}
+	}
+
+	if _, err := collectBatchJobs(t.TempDir()); !errors.Is(err, ErrNoBoards) {
+		t.Errorf("Expected ErrNoBoards for an empty directory, got %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidBends = errors.New("curvas inválidas")
+
+// DEFAULT_BEND_ANGLE é o ângulo permitido quando a especificação não informa nenhum
+const DEFAULT_BEND_ANGLE = 90
+
+// BendOptions permite que a busca em linha reta mude de direção no meio da palavra.
+// MaxTurns zero mantém a busca reta original.
+type BendOptions struct {
+	MaxTurns int
+	// Angles são os ângulos de curva permitidos, em graus, medidos na grade
+	Angles []int
+}
+
+// ParseBends converte especificações como "1", "2@90" ou "1@45,90"
+func ParseBends(spec string) (BendOptions, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return BendOptions{}, nil
+	}
+	turnsSpec, anglesSpec, hasAngles := strings.Cut(spec, "@")
+	turns, err := strconv.Atoi(strings.TrimSpace(turnsSpec))
+	if err != nil || turns < 0 {
+		return BendOptions{}, fmt.Errorf("%w: número de curvas %q", ErrInvalidBends, turnsSpec)
+	}
+	options := BendOptions{MaxTurns: turns, Angles: []int{DEFAULT_BEND_ANGLE}}
+	if !hasAngles {
+		return options, nil
+	}
+	options.Angles = options.Angles[:0]
+	for part := range strings.SplitSeq(anglesSpec, ",") {
+		angle, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || angle <= 0 || angle >= 180 {
+			return BendOptions{}, fmt.Errorf("%w: ângulo %q (entre 1 e 179)", ErrInvalidBends, part)
+		}
+		options.Angles = append(options.Angles, angle)
+	}
+	return options, nil
+}
+
+func (bo BendOptions) String() string {
+	if bo.MaxTurns == 0 {
+		return ""
+	}
+	angles := make([]string, len(bo.Angles))
+	for i, angle := range bo.Angles {
+		angles[i] = strconv.Itoa(angle)
+	}
+	return fmt.Sprintf("%d@%s", bo.MaxTurns, strings.Join(angles, ","))
+}
+
+// turnAngle retorna o ângulo entre duas direções, arredondado para graus inteiros.
+// Em hex, os deslocamentos são convertidos para a geometria real da grade antes do cálculo.
+func turnAngle(from, to Direction, hex bool) int {
+	fromX, fromY := directionVector(from, hex)
+	toX, toY := directionVector(to, hex)
+	a := math.Atan2(fromY, fromX)
+	b := math.Atan2(toY, toX)
+	degrees := math.Abs(a-b) * 180 / math.Pi
+	if degrees > 180 {
+		degrees = 360 - degrees
+	}
+	return int(math.Round(degrees))
+}
+
+// directionVector retorna o deslocamento da direção no plano. Em hex (odd-r), os deslocamentos
+// são os das linhas pares: as linhas vizinhas ficam meia célula à direita e a sqrt(3)/2 de altura.
+func directionVector(direction Direction, hex bool) (x, y float64) {
+	x, y = float64(direction.DeltaCol), float64(direction.DeltaRow)
+	if hex {
+		if direction.DeltaRow%2 != 0 {
+			x += 0.5
+		}
+		y *= math.Sqrt(3) / 2
+	}
+	return x, y
+}
+
+// turnTable lista, para cada direção, os índices das direções para as quais se pode virar
+func (bo BendOptions) turnTable(directions []Direction, hex bool) [][]int {
+	table := make([][]int, len(directions))
+	for i, from := range directions {
+		for j, to := range directions {
+			angle := turnAngle(from, to, hex)
+			for _, allowed := range bo.Angles {
+				if angle == allowed {
+					table[i] = append(table[i], j)
+					break
+				}
+			}
+		}
+	}
+	return table
+}
+
+// GetBends retorna as curvas permitidas na busca em linha reta desta matriz
+func (lm *LetterMatrix) GetBends() BendOptions {
+	return lm.bends
+}
+
+// SetBends define as curvas permitidas na busca em linha reta desta matriz
+func (lm *LetterMatrix) SetBends(bends BendOptions) {
+	lm.bends = bends
+}
+
+// bentScan guarda o estado de uma busca com curvas a partir de uma célula
+type bentScan struct {
+	ws    *WordSearcher
+	ctx   context.Context
+	steps int
+	start Coord
+	word  []rune
+	path  []Coord
+	// remaining são as letras da matriz ainda fora do caminho
+	remaining letterBag
+	segments  []string
+	emit      func(WordResult) bool
+}
+
+// scanBent segue a direção atual e, enquanto houver curvas disponíveis, vira para as direções
+// permitidas; retorna false se emit interromper a busca
+func (bs *bentScan) scanBent(cell Coord, dirIndex, turns int) bool {
+	// Cancelar o contexto interrompe a busca como se emit tivesse recusado o resultado
+	if bs.steps++; bs.steps%1024 == 0 && bs.ctx.Err() != nil {
+		return false
+	}
+	ws := bs.ws
+	char := ws.matrix.GetMatrix()[cell.X][cell.Y]
+	if !isLetter(char) || containsCoord(bs.path, cell) {
+		return true
+	}
+
+	bs.word = append(bs.word, unicode.ToUpper(char))
+	bs.path = append(bs.path, cell)
+	if !ws.staticLetters {
+		bs.remaining.take(char)
+	}
+	defer func() {
+		bs.word = bs.word[:len(bs.word)-1]
+		bs.path = bs.path[:len(bs.path)-1]
+		if !ws.staticLetters {
+			bs.remaining.put(char)
+		}
+	}()
+
+	sequence := string(bs.word)
+	node := ws.dictionary.lookup(sequence)
+	if node == nil {
+		return true
+	}
+	if len(bs.word) >= 3 && node.isWord {
+		result := WordResult{
+			Word:      sequence,
+			StartRow:  bs.start.X,
+			StartCol:  bs.start.Y,
+			Direction: strings.Join(bs.segments, ">"),
+			Length:    len(bs.word),
+			Path:      append([]Coord(nil), bs.path...),
+			Score:     ws.scorer.Score(sequence, bs.path, ws.matrix),
+		}
+		if !bs.emit(result) {
+			return false
+		}
+	}
+
+	if !node.canExtend(bs.remaining.set) {
+		return true
+	}
+	rows, cols := ws.matrix.GetDimensions()
+	adjacency := ws.matrix.GetAdjacency()
+	if next, ok := adjacency.Step(cell, ws.directions[dirIndex], rows, cols); ok {
+		if !bs.scanBent(next, dirIndex, turns) {
+			return false
+		}
+	}
+	// A curva só acontece depois da primeira letra; virar antes é outra direção inicial
+	if turns >= ws.bends.MaxTurns || len(bs.path) < 2 {
+		return true
+	}
+	for _, turn := range ws.turns[dirIndex] {
+		next, ok := adjacency.Step(cell, ws.directions[turn], rows, cols)
+		if !ok {
+			continue
+		}
+		bs.segments = append(bs.segments, ws.directions[turn].Name)
+		keepGoing := bs.scanBent(next, turn, turns+1)
+		bs.segments = bs.segments[:len(bs.segments)-1]
+		if !keepGoing {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParseBends tests the turn count and angle specification
+func TestParseBends(t *testing.T) {
+	testCases := []struct {
+		spec     string
+		expected BendOptions
+	}{
+		{"", BendOptions{}},
+		{"1", BendOptions{MaxTurns: 1, Angles: []int{90}}},
+		{"2@45,90", BendOptions{MaxTurns: 2, Angles: []int{45, 90}}},
+	}
+	for _, tc := range testCases {
+		got, err := ParseBends(tc.spec)
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", tc.spec, err)
+			continue
+		}
+		if got.MaxTurns != tc.expected.MaxTurns || !slices.Equal(got.Angles, tc.expected.Angles) {
+			t.Errorf("%q: expected %+v, got %+v", tc.spec, tc.expected, got)
+		}
+	}
+
+	for _, spec := range []string{"x", "-1", "1@180", "1@a", "1@0"} {
+		if _, err := ParseBends(spec); !errors.Is(err, ErrInvalidBends) {
+			t.Errorf("%q: expected ErrInvalidBends, got %v", spec, err)
+		}
+	}
+}
+
+// TestTurnAngle tests the angle between the straight line directions
+func TestTurnAngle(t *testing.T) {
+	right := lineDirections[0]
+	testCases := map[string]int{R: 0, B: 90, T: 90, BR: 45, TR: 45, BL: 135, L: 180}
+	for _, direction := range lineDirections {
+		if expected, ok := testCases[direction.Name]; ok {
+			if got := turnAngle(right, direction, false); got != expected {
+				t.Errorf("R to %s: expected %d, got %d", direction.Name, expected, got)
+			}
+		}
+	}
+
+	hex, _ := ParseAdjacency("hex")
+	hexCases := map[string]int{R: 0, BR: 60, TR: 60, BL: 120, TL: 120, L: 180}
+	for _, direction := range hex.Lines() {
+		if got := turnAngle(right, direction, true); got != hexCases[direction.Name] {
+			t.Errorf("hex R to %s: expected %d, got %d", direction.Name, hexCases[direction.Name], got)
+		}
+	}
+}
+
+// TestBentSearchHex tests that hex turns use the hexagonal angles
+func TestBentSearchHex(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	// PLA runs R along the first row, then NET goes BR through (2,3), (3,4) and (4,4) on the odd-r grid
+	grid := []string{"plax", "xxnx", "xxxe", "xxxt"}
+	for bends, expected := range map[string]int{"1@60": 1, "1@90": 0} {
+		matrix, err := parseBoard(append([]string{"---", "game: wordsearch", "adjacency: hex", "bends: " + bends, "---"}, grid...))
+		if err != nil {
+			t.Fatalf("Failed to parse board: %v", err)
+		}
+		searcher := NewWordSimpleSearcher(matrix, dict)
+		searcher.SearchAllWords(1)
+		if got := len(searcher.GetResults()); got != expected {
+			t.Errorf("bends %s: expected %d results, got %v", bends, expected, searcher.GetResults())
+		}
+	}
+}
+
+// TestBentSearch tests words with a limited number of turns in the line searcher
+func TestBentSearch(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+
+	testCases := []struct {
+		name     string
+		grid     []string
+		bends    string
+		expected []string
+	}{
+		{"straight only", []string{"plax", "xxnx", "xxex", "xxtx"}, "", nil},
+		{"one right angle", []string{"plax", "xxnx", "xxex", "xxtx"}, "1", []string{"PLANET R>B (1,1)(1,2)(1,3)(2,3)(3,3)(4,3)"}},
+		{"angle not allowed", []string{"plax", "xxnx", "xxex", "xxtx"}, "1@45", nil},
+		{"snake needs two turns", []string{"plxx", "xaxx", "xnet"}, "1", nil},
+		{"snake", []string{"plxx", "xaxx", "xnet"}, "2", []string{"PLANET R>B>R (1,1)(1,2)(2,2)(3,2)(3,3)(3,4)"}},
+	}
+	for _, tc := range testCases {
+		header := []string{"---", "game: wordsearch"}
+		if tc.bends != "" {
+			header = append(header, "bends: "+tc.bends)
+		}
+		matrix, err := parseBoard(append(append(header, "---"), tc.grid...))
+		if err != nil {
+			t.Fatalf("%s: failed to parse board: %v", tc.name, err)
+		}
+		var got []string
+		for result := range NewWordSimpleSearcher(matrix, dict).Stream(context.Background()) {
+			got = append(got, result.Word+" "+result.Direction+" "+PathResult{Coordinates: result.Path}.Path())
+		}
+		if !slices.Equal(got, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, got)
+		}
+	}
+}
+
+// TestBentSearchNoCellReuse tests that turning back around a square cannot reuse a cell
+func TestBentSearchNoCellReuse(t *testing.T) {
+	dict := loadTestDictionary(t, "ABCDAB", "ABCDEF")
+	matrix, err := parseBoard([]string{"---", "game: wordsearch", "bends: 4", "---", "abx", "dcx", "efx"})
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	searcher := NewWordSimpleSearcher(matrix, dict)
+	searcher.SearchAllWords(2)
+
+	results := searcher.GetResults()
+	if len(results) != 1 || results[0].Word != "ABCDEF" || results[0].Direction != "R>B>L>B>R" {
+		t.Errorf("Expected only ABCDEF with four turns, got %v", results)
+	}
+}
+
+// TestBentSearchDeadline tests that a cancelled context stops a bent search inside a line
+func TestBentSearchDeadline(t *testing.T) {
+	dict := loadTestDictionary(t, strings.Repeat("A", 60))
+	lines := []string{"---", "game: wordsearch", "bends: 30@45,90,135", "---"}
+	for range 300 {
+		lines = append(lines, strings.Repeat("a", 30))
+	}
+	matrix, err := parseBoard(lines)
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	scorer, _ := NewScorer(DEFAULT_RULES)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := searchBoardContext(ctx, matrix, dict, scorer); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the deadline error, got %v", err)
+	}
+	for range Solve(ctx, matrix, dict, scorer) {
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected the search to stop at the deadline, took %v", elapsed)
+	}
+}
@@ -0,0 +1,421 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("formato de matriz não suportado")
+	ErrInvalidHeader     = errors.New("cabeçalho de matriz inválido")
+)
+
+// Formato versionado de matriz com metadados
+const (
+	BOARD_FORMAT_V1        = "wordgo/1"
+	BOARD_HEADER_DELIMITER = "---"
+	// MAX_BOARD_LINE limita o tamanho de uma linha (JSON em linha única)
+	MAX_BOARD_LINE = 1 << 20
+)
+
+// Tipos de jogo reconhecidos no cabeçalho
+const (
+	GameFree       = "free"
+	GameTower      = "tower"
+	GameBoggle     = "boggle"
+	GameWordSearch = "wordsearch"
+)
+
+// Multiplier é um multiplicador de célula: Kind 'L' multiplica a letra, 'W' a palavra
+type Multiplier struct {
+	Kind   byte
+	Factor int
+}
+
+// String retorna o multiplicador no formato do cabeçalho, ex: "2L", "3W"
+func (m Multiplier) String() string {
+	return fmt.Sprintf("%d%c", m.Factor, m.Kind)
+}
+
+// parseMultiplier interpreta "2L", "3W" ou "x2" (equivale a "2W")
+func parseMultiplier(spec string) (Multiplier, error) {
+	spec = strings.ToUpper(strings.TrimSpace(spec))
+	if factor, found := strings.CutPrefix(spec, "X"); found {
+		spec = factor + "W"
+	}
+	if len(spec) < 2 {
+		return Multiplier{}, fmt.Errorf("%w: multiplicador %q", ErrInvalidHeader, spec)
+	}
+	kind := spec[len(spec)-1]
+	factor, err := strconv.Atoi(spec[:len(spec)-1])
+	if err != nil || factor < 1 || (kind != 'L' && kind != 'W') {
+		return Multiplier{}, fmt.Errorf("%w: multiplicador %q", ErrInvalidHeader, spec)
+	}
+	return Multiplier{Kind: kind, Factor: factor}, nil
+}
+
+// BoardMeta são os metadados opcionais de uma matriz
+type BoardMeta struct {
+	Format      string
+	Game        string
+	Adjacency   string
+	Bends       string
+	Gravity     string
+	Seed        uint64
+	Dictionary  string
+	Rules       string
+	Multipliers map[Coord]Multiplier
+	// Extra guarda chaves desconhecidas para não perdê-las ao regravar
+	Extra map[string]string
+}
+
+// boardJSON é o esquema JSON da matriz
+type boardJSON struct {
+	Format      string           `json:"format"`
+	Game        string           `json:"game,omitempty"`
+	Adjacency   string           `json:"adjacency,omitempty"`
+	Bends       string           `json:"bends,omitempty"`
+	Gravity     string           `json:"gravity,omitempty"`
+	Seed        uint64           `json:"seed,omitempty"`
+	Dictionary  string           `json:"dictionary,omitempty"`
+	Rules       string           `json:"rules,omitempty"`
+	Multipliers []multiplierJSON `json:"multipliers,omitempty"`
+	Grid        []string         `json:"grid"`
+}
+
+type multiplierJSON struct {
+	Row  int    `json:"row"`
+	Col  int    `json:"col"`
+	Kind string `json:"kind"`
+}
+
+// parseBoard identifica o formato pelo conteúdo: cabeçalho "---", JSON ou linhas simples
+func parseBoard(lines []string) (*LetterMatrix, error) {
+	first := ""
+	for _, line := range lines {
+		if first = strings.TrimSpace(line); first != "" {
+			break
+		}
+	}
+
+	switch {
+	case first == BOARD_HEADER_DELIMITER:
+		return parseFrontMatterBoard(lines)
+	case strings.HasPrefix(first, "{"):
+		return parseJSONBoard([]byte(strings.Join(lines, "\n")))
+	}
+	return parsePlainBoard(lines, BoardMeta{})
+}
+
+// parsePlainBoard monta a matriz a partir das linhas da grade, ignorando linhas vazias
+func parsePlainBoard(lines []string, meta BoardMeta) (*LetterMatrix, error) {
+	var matrix [][]rune
+	var maxCols int
+	for _, line := range lines {
+		if line != "" {
+			row := []rune(line)
+			if len(row) > maxCols {
+				maxCols = len(row)
+			}
+			matrix = append(matrix, row)
+		}
+	}
+	if len(matrix) == 0 {
+		return nil, ErrEmptyMatrix
+	}
+
+	lm := newLetterMatrixFromRuneMatrix(matrix, maxCols)
+	if err := lm.applyMeta(meta); err != nil {
+		return nil, err
+	}
+	return lm, nil
+}
+
+// parseFrontMatterBoard lê o cabeçalho "chave: valor" entre delimitadores "---" e a grade em seguida
+func parseFrontMatterBoard(lines []string) (*LetterMatrix, error) {
+	meta := BoardMeta{Format: BOARD_FORMAT_V1}
+	start := 0
+	for strings.TrimSpace(lines[start]) != BOARD_HEADER_DELIMITER {
+		start++
+	}
+
+	for i := start + 1; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == BOARD_HEADER_DELIMITER {
+			return parsePlainBoard(lines[i+1:], meta)
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			return nil, fmt.Errorf("%w: linha %d: %q", ErrInvalidHeader, i+1, line)
+		}
+		if err := meta.set(strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)); err != nil {
+			return nil, fmt.Errorf("linha %d: %w", i+1, err)
+		}
+	}
+	return nil, fmt.Errorf("%w: delimitador %q de fechamento ausente", ErrInvalidHeader, BOARD_HEADER_DELIMITER)
+}
+
+// set atribui uma chave do cabeçalho
+func (meta *BoardMeta) set(key, value string) error {
+	switch key {
+	case "format":
+		meta.Format = value
+	case "game":
+		meta.Game = strings.ToLower(value)
+	case "adjacency":
+		meta.Adjacency = strings.ToLower(value)
+	case "bends":
+		meta.Bends = value
+	case "gravity":
+		meta.Gravity = strings.ToLower(value)
+	case "seed":
+		seed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: seed %q", ErrInvalidHeader, value)
+		}
+		meta.Seed = seed
+	case "dictionary":
+		meta.Dictionary = value
+	case "rules":
+		meta.Rules = value
+	case "multiplier":
+		// multiplier: linha,coluna fator (base 1), ex: "1,3 2W"
+		position, spec, _ := strings.Cut(value, " ")
+		rowSpec, colSpec, _ := strings.Cut(position, ",")
+		row, errRow := strconv.Atoi(strings.TrimSpace(rowSpec))
+		col, errCol := strconv.Atoi(strings.TrimSpace(colSpec))
+		if errRow != nil || errCol != nil {
+			return fmt.Errorf("%w: posição do multiplicador %q", ErrInvalidHeader, position)
+		}
+		multiplier, err := parseMultiplier(spec)
+		if err != nil {
+			return err
+		}
+		meta.addMultiplier(Coord{X: row - 1, Y: col - 1}, multiplier)
+	default:
+		if meta.Extra == nil {
+			meta.Extra = make(map[string]string)
+		}
+		meta.Extra[key] = value
+	}
+	return nil
+}
+
+func (meta *BoardMeta) addMultiplier(coord Coord, multiplier Multiplier) {
+	if meta.Multipliers == nil {
+		meta.Multipliers = make(map[Coord]Multiplier)
+	}
+	meta.Multipliers[coord] = multiplier
+}
+
+// parseJSONBoard lê o esquema JSON da matriz
+func parseJSONBoard(data []byte) (*LetterMatrix, error) {
+	var board boardJSON
+	if err := json.Unmarshal(data, &board); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHeader, err)
+	}
+	meta := BoardMeta{
+		Format:     board.Format,
+		Game:       strings.ToLower(board.Game),
+		Adjacency:  strings.ToLower(board.Adjacency),
+		Bends:      board.Bends,
+		Gravity:    strings.ToLower(board.Gravity),
+		Seed:       board.Seed,
+		Dictionary: board.Dictionary,
+		Rules:      board.Rules,
+	}
+	if meta.Format == "" {
+		meta.Format = BOARD_FORMAT_V1
+	}
+	for _, m := range board.Multipliers {
+		multiplier, err := parseMultiplier(m.Kind)
+		if err != nil {
+			return nil, err
+		}
+		meta.addMultiplier(Coord{X: m.Row - 1, Y: m.Col - 1}, multiplier)
+	}
+	return parsePlainBoard(board.Grid, meta)
+}
+
+// applyMeta valida a versão e aplica adjacência, curvas, gravidade e multiplicadores à matriz
+func (lm *LetterMatrix) applyMeta(meta BoardMeta) error {
+	if meta.Format != "" && meta.Format != BOARD_FORMAT_V1 {
+		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, meta.Format)
+	}
+	for coord := range meta.Multipliers {
+		if coord.X < 0 || coord.X >= lm.rows || coord.Y < 0 || coord.Y >= lm.cols {
+			return fmt.Errorf("%w: multiplicador fora da matriz em (%d,%d)", ErrInvalidHeader, coord.X+1, coord.Y+1)
+		}
+		if lm.matrix[coord.X][coord.Y] == MASK_CELL {
+			return fmt.Errorf("%w: multiplicador em célula bloqueada (%d,%d)", ErrInvalidHeader, coord.X+1, coord.Y+1)
+		}
+	}
+	if meta.Adjacency != "" {
+		adjacency, err := ParseAdjacency(meta.Adjacency)
+		if err != nil {
+			return err
+		}
+		if err := lm.SetAdjacency(adjacency); err != nil {
+			return err
+		}
+	}
+	if meta.Bends != "" {
+		bends, err := ParseBends(meta.Bends)
+		if err != nil {
+			return err
+		}
+		lm.SetBends(bends)
+	}
+	if meta.Gravity != "" {
+		gravity, err := ParseGravity(meta.Gravity, meta.Seed)
+		if err != nil {
+			return err
+		}
+		lm.SetGravity(gravity)
+	}
+	lm.meta = meta
+	return nil
+}
+
+// GetMeta retorna os metadados da matriz
+func (lm *LetterMatrix) GetMeta() BoardMeta {
+	return lm.meta
+}
+
+// SetMeta substitui os metadados, aplicando gravidade e multiplicadores
+func (lm *LetterMatrix) SetMeta(meta BoardMeta) error {
+	if meta.Format == "" {
+		meta.Format = BOARD_FORMAT_V1
+	}
+	return lm.applyMeta(meta)
+}
+
+// GetMultiplier retorna o multiplicador da célula, se houver
+func (lm *LetterMatrix) GetMultiplier(coord Coord) (Multiplier, bool) {
+	multiplier, ok := lm.meta.Multipliers[coord]
+	return multiplier, ok
+}
+
+// WriteBoard grava a matriz no formato com cabeçalho; sem metadados grava só a grade
+func (lm *LetterMatrix) WriteBoard(w io.Writer) error {
+	meta := lm.meta
+	header := make([]string, 0, 8)
+	add := func(key, value string) {
+		if value != "" {
+			header = append(header, key+": "+value)
+		}
+	}
+	add("game", meta.Game)
+	add("adjacency", meta.Adjacency)
+	add("bends", meta.Bends)
+	add("gravity", meta.Gravity)
+	if meta.Seed != 0 {
+		add("seed", strconv.FormatUint(meta.Seed, 10))
+	}
+	add("dictionary", meta.Dictionary)
+	add("rules", meta.Rules)
+
+	coords := make([]Coord, 0, len(meta.Multipliers))
+	for coord := range meta.Multipliers {
+		coords = append(coords, coord)
+	}
+	sort.Slice(coords, func(i, j int) bool {
+		return coords[i].X < coords[j].X || (coords[i].X == coords[j].X && coords[i].Y < coords[j].Y)
+	})
+	for _, coord := range coords {
+		add("multiplier", fmt.Sprintf("%d,%d %s", coord.X+1, coord.Y+1, meta.Multipliers[coord]))
+	}
+
+	extras := make([]string, 0, len(meta.Extra))
+	for key := range meta.Extra {
+		extras = append(extras, key)
+	}
+	sort.Strings(extras)
+	for _, key := range extras {
+		add(key, meta.Extra[key])
+	}
+
+	var sb strings.Builder
+	if len(header) > 0 {
+		sb.WriteString(BOARD_HEADER_DELIMITER + "\n")
+		sb.WriteString("format: " + BOARD_FORMAT_V1 + "\n")
+		for _, line := range header {
+			sb.WriteString(line + "\n")
+		}
+		sb.WriteString(BOARD_HEADER_DELIMITER + "\n")
+	}
+	sb.WriteString(lm.String() + "\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// writeBoardFile grava a matriz com os metadados informados no arquivo
+func writeBoardFile(filename string, matrix *LetterMatrix, meta BoardMeta) error {
+	board := matrix.Clone()
+	if err := board.SetMeta(meta); err != nil {
+		return err
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := board.WriteBoard(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ReadBoards lê várias matrizes separadas por linhas em branco. Linhas em branco dentro
+// de um cabeçalho "---" não separam matrizes; matrizes JSON não podem conter linhas em branco.
+func ReadBoards(r io.Reader) ([]*LetterMatrix, error) {
+	lines, err := readBoardLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var boards []*LetterMatrix
+	var block []string
+	inHeader := false
+	flush := func() error {
+		if len(block) == 0 {
+			return nil
+		}
+		board, err := parseBoard(block)
+		if err != nil {
+			return fmt.Errorf("matriz %d: %w", len(boards)+1, err)
+		}
+		boards = append(boards, board)
+		block = nil
+		return nil
+	}
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == BOARD_HEADER_DELIMITER {
+			inHeader = !inHeader
+		}
+		if line == "" && !inHeader {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		block = append(block, line)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if len(boards) == 0 {
+		return nil, ErrEmptyMatrix
+	}
+	return boards, nil
+}
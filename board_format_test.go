@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestBoardFormatPlain tests that plain grids still load without metadata
+func TestBoardFormatPlain(t *testing.T) {
+	matrixFile := createTempFile(t, "test_board_plain_*.txt", "abc\n\ndEf\n")
+	defer matrixFile.Close()
+	defer os.Remove(matrixFile.Name())
+
+	matrix, err := NewLetterMatrixFromFile(matrixFile.Name())
+	if err != nil {
+		t.Fatalf("Failed to load matrix: %v", err)
+	}
+	if matrix.String() != "abc\ndEf" {
+		t.Errorf("Unexpected grid %q", matrix.String())
+	}
+	if meta := matrix.GetMeta(); meta.Format != "" || meta.Game != "" {
+		t.Errorf("Plain board should have no metadata, got %+v", meta)
+	}
+	if !matrix.IsSpecial(Coord{X: 1, Y: 1}) {
+		t.Error("Uppercase cell should still be special")
+	}
+}
+
+// TestBoardFormatHeader tests the front-matter header and its round trip through WriteBoard
+func TestBoardFormatHeader(t *testing.T) {
+	content := "---\n" +
+		"format: wordgo/1\n" +
+		"# comentário\n" +
+		"game: Tower\n" +
+		"gravity: down+refill\n" +
+		"seed: 7\n" +
+		"dictionary: res/words.txt\n" +
+		"rules: scrabble\n" +
+		"multiplier: 1,2 3L\n" +
+		"multiplier: 2,3 x2\n" +
+		"author: someone\n" +
+		"---\n" +
+		"abc\n" +
+		"def\n"
+	matrixFile := createTempFile(t, "test_board_header_*.txt", content)
+	defer matrixFile.Close()
+	defer os.Remove(matrixFile.Name())
+
+	matrix, err := NewLetterMatrixFromFile(matrixFile.Name())
+	if err != nil {
+		t.Fatalf("Failed to load matrix: %v", err)
+	}
+	meta := matrix.GetMeta()
+	if meta.Game != GameTower || meta.Rules != "scrabble" || meta.Dictionary != "res/words.txt" || meta.Seed != 7 {
+		t.Errorf("Unexpected metadata %+v", meta)
+	}
+	if gravity := matrix.GetGravity(); !gravity.Refill || gravity.Seed != 7 {
+		t.Errorf("Header gravity not applied, got %s", gravity)
+	}
+	if m, ok := matrix.GetMultiplier(Coord{X: 0, Y: 1}); !ok || m != (Multiplier{Kind: 'L', Factor: 3}) {
+		t.Errorf("Expected 3L at (1,2), got %v %t", m, ok)
+	}
+	if m, ok := matrix.GetMultiplier(Coord{X: 1, Y: 2}); !ok || m != (Multiplier{Kind: 'W', Factor: 2}) {
+		t.Errorf("Expected 2W at (2,3), got %v %t", m, ok)
+	}
+	if meta.Extra["author"] != "someone" {
+		t.Errorf("Unknown key should be kept, got %v", meta.Extra)
+	}
+
+	var buf bytes.Buffer
+	if err := matrix.WriteBoard(&buf); err != nil {
+		t.Fatalf("WriteBoard failed: %v", err)
+	}
+	lines := []string{}
+	for _, line := range bytes.Split(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), []byte("\n")) {
+		lines = append(lines, string(line))
+	}
+	reloaded, err := parseBoard(lines)
+	if err != nil {
+		t.Fatalf("Failed to reload written board: %v\n%s", err, buf.String())
+	}
+	if reloaded.String() != matrix.String() || len(reloaded.GetMeta().Multipliers) != 2 || reloaded.GetMeta().Game != GameTower {
+		t.Errorf("Round trip lost data:\n%s", buf.String())
+	}
+}
+
+// TestBoardFormatJSON tests the JSON board schema
+func TestBoardFormatJSON(t *testing.T) {
+	content := `{
+  "format": "wordgo/1",
+  "game": "boggle",
+  "adjacency": "king",
+  "multipliers": [{"row": 1, "col": 1, "kind": "2W"}],
+  "grid": ["plan", "etsx"]
+}`
+	matrixFile := createTempFile(t, "test_board_json_*.json", content)
+	defer matrixFile.Close()
+	defer os.Remove(matrixFile.Name())
+
+	matrix, err := NewLetterMatrixFromFile(matrixFile.Name())
+	if err != nil {
+		t.Fatalf("Failed to load matrix: %v", err)
+	}
+	if matrix.String() != "plan\netsx" {
+		t.Errorf("Unexpected grid %q", matrix.String())
+	}
+	meta := matrix.GetMeta()
+	if meta.Game != GameBoggle || meta.Adjacency != "king" {
+		t.Errorf("Unexpected metadata %+v", meta)
+	}
+	if _, ok := matrix.GetMultiplier(Coord{X: 0, Y: 0}); !ok {
+		t.Error("Expected multiplier at (1,1)")
+	}
+}
+
+// TestBoardFormatErrors tests that invalid headers are rejected with the sentinel errors
+func TestBoardFormatErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lines    []string
+		expected error
+	}{
+		{"future version", []string{"---", "format: wordgo/2", "---", "abc"}, ErrUnsupportedFormat},
+		{"missing delimiter", []string{"---", "game: tower", "abc"}, ErrInvalidHeader},
+		{"bad multiplier", []string{"---", "multiplier: 1,1 2Q", "---", "abc"}, ErrInvalidHeader},
+		{"multiplier outside", []string{"---", "multiplier: 3,1 2L", "---", "abc"}, ErrInvalidHeader},
+		{"bad gravity", []string{"---", "gravity: sideways", "---", "abc"}, ErrUnknownGravity},
+		{"empty grid", []string{"---", "game: tower", "---"}, ErrEmptyMatrix},
+	}
+
+	for _, tc := range testCases {
+		if _, err := parseBoard(tc.lines); !errors.Is(err, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, err)
+		}
+	}
+}
+
+// TestBoardMultiplierScoring tests that tile multipliers feed the scrabble rules
+func TestBoardMultiplierScoring(t *testing.T) {
+	matrix, err := parseBoard([]string{"---", "multiplier: 1,1 3L", "multiplier: 1,6 2W", "---", "planet"})
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	scorer, err := NewScorer("scrabble")
+	if err != nil {
+		t.Fatalf("NewScorer failed: %v", err)
+	}
+	path := []Coord{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {0, 4}, {0, 5}}
+	// P is worth 3, tripled; the whole word is doubled
+	if score := scorer.Score("planet", path, matrix); score.Total != (9+5)*2 {
+		t.Errorf("Expected %d, got %s", (9+5)*2, score)
+	}
+}
+
+// TestReadBoards tests batch input with boards separated by blank lines
+func TestReadBoards(t *testing.T) {
+	input := "abc\ndef\n\n\n" +
+		"---\ngame: tower\n\n# blank line above stays in the header\n---\nghi\n\n" +
+		"{\"grid\": [\"jk\", \"lm\"]}\n"
+	boards, err := ReadBoards(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ReadBoards failed: %v", err)
+	}
+	expected := []string{"abc\ndef", "ghi", "jk\nlm"}
+	if len(boards) != len(expected) {
+		t.Fatalf("Expected %d boards, got %d", len(expected), len(boards))
+	}
+	for i, board := range boards {
+		if board.String() != expected[i] {
+			t.Errorf("Board %d: expected %q, got %q", i+1, expected[i], board.String())
+		}
+	}
+	if boards[1].GetMeta().Game != GameTower {
+		t.Errorf("Header of the second board was lost: %+v", boards[1].GetMeta())
+	}
+
+	if _, err := ReadBoards(strings.NewReader("abc\n\n---\nformat: wordgo/9\n---\ndef\n")); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("Expected ErrUnsupportedFormat, got %v", err)
+	}
+	if _, err := ReadBoards(strings.NewReader("\n\n")); !errors.Is(err, ErrEmptyMatrix) {
+		t.Errorf("Expected ErrEmptyMatrix, got %v", err)
+	}
+}
@@ -1,9 +1,6 @@
 package main
 
-import (
-	"errors"
-	"strings"
-)
+import "errors"
 
 var (
 	ErrOutOfBoundariesLeft   = errors.New("out of boundaries <")
@@ -17,35 +14,18 @@ type Coord struct {
 	Y int
 }
 
-func (c *Coord) next(pos string, rows int, cols int) (*Coord, error) {
-	// Use simple variables for updates and validation
-	newRow, newCol := c.X, c.Y
-
-	if strings.Contains(pos, T) {
-		newRow--
-		if newRow < 0 {
-			return nil, ErrOutOfBoundariesLeft
-		}
-	}
-	if strings.Contains(pos, B) {
-		newRow++
-		if newRow >= rows {
-			return nil, ErrOutOfBoundariesRight
-		}
+// next retorna a célula vizinha na direção informada ou o erro da borda atravessada
+func (c Coord) next(direction Direction, rows int, cols int) (Coord, error) {
+	newRow, newCol := c.X+direction.DeltaRow, c.Y+direction.DeltaCol
+	switch {
+	case newRow < 0:
+		return Coord{}, ErrOutOfBoundariesTop
+	case newRow >= rows:
+		return Coord{}, ErrOutOfBoundariesBottom
+	case newCol < 0:
+		return Coord{}, ErrOutOfBoundariesLeft
+	case newCol >= cols:
+		return Coord{}, ErrOutOfBoundariesRight
 	}
-	if strings.Contains(pos, L) {
-		newCol--
-		if newCol < 0 {
-			return nil, ErrOutOfBoundariesTop
-		}
-	}
-	if strings.Contains(pos, R) {
-		newCol++
-		if newCol >= cols {
-			return nil, ErrOutOfBoundariesBottom
-		}
-	}
-
-	// Only create Coord object if all validations pass
-	return &Coord{X: newRow, Y: newCol}, nil
+	return Coord{X: newRow, Y: newCol}, nil
 }
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestCoordNext tests stepping to a neighbour and the error of each crossed border
+func TestCoordNext(t *testing.T) {
+	testCases := []struct {
+		from      Coord
+		direction Direction
+		expected  Coord
+		err       error
+	}{
+		{Coord{1, 1}, Direction{Name: BR, DeltaRow: 1, DeltaCol: 1}, Coord{2, 2}, nil},
+		{Coord{0, 1}, Direction{Name: T, DeltaRow: -1}, Coord{}, ErrOutOfBoundariesTop},
+		{Coord{2, 1}, Direction{Name: B, DeltaRow: 1}, Coord{}, ErrOutOfBoundariesBottom},
+		{Coord{1, 0}, Direction{Name: L, DeltaCol: -1}, Coord{}, ErrOutOfBoundariesLeft},
+		{Coord{1, 2}, Direction{Name: R, DeltaCol: 1}, Coord{}, ErrOutOfBoundariesRight},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.from.next(tc.direction, 3, 3)
+		if !errors.Is(err, tc.err) || got != tc.expected {
+			t.Errorf("%v %s: expected %v (%v), got %v (%v)", tc.from, tc.direction.Name, tc.expected, tc.err, got, err)
+		}
+	}
+}
+
+// TestLineDirections tests that the shared king directions are 8 distinct unit steps
+func TestLineDirections(t *testing.T) {
+	if len(lineDirections) != 8 {
+		t.Fatalf("Expected 8 directions, got %d", len(lineDirections))
+	}
+	seen := make(map[[2]int]bool)
+	for _, direction := range lineDirections {
+		delta := [2]int{direction.DeltaRow, direction.DeltaCol}
+		if direction.Name == "" || delta == [2]int{} || seen[delta] {
+			t.Errorf("Invalid or repeated direction %+v", direction)
+		}
+		seen[delta] = true
+	}
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 )
 
@@ -17,6 +19,14 @@ type Dictionary struct {
 type TrieNode struct {
 	children map[rune]*TrieNode
 	isWord   bool
+	// Metadados da subárvore, calculados por annotate na carga:
+	// maxDepth é o maior número de letras que ainda se pode acrescentar até formar uma palavra,
+	// letters são as letras alcançáveis abaixo, required as presentes em toda continuação
+	// e words a quantidade de palavras na subárvore, incluindo o próprio nó
+	maxDepth int
+	letters  letterSet
+	required letterSet
+	words    int
 }
 
 // NewDictionary cria um novo dicionário a partir de um arquivo
@@ -44,6 +54,7 @@ func NewDictionary(filename string) (*Dictionary, error) {
 	if err := scanner.Err(); err != nil {
 		return nil, fmt.Errorf("%s do dicionário: %w", ErrFileRead, err)
 	}
+	dict.trie.annotate()
 
 	return dict, nil
 }
@@ -98,3 +109,74 @@ func (d *Dictionary) IsWord(sequence string) bool {
 func (d *Dictionary) PrintDictionaryStats() {
 	fmt.Printf("Dicionário carregado com %d palavras\n", len(d.words))
 }
+
+// ANAGRAM_WILDCARD é a letra curinga aceita por Anagrams
+const ANAGRAM_WILDCARD = '?'
+
+// Anagrams retorna as palavras formáveis com as letras informadas, das mais longas para as mais curtas.
+// Cada '?' vale qualquer letra; com exact, só palavras que usam todas as letras.
+func (d *Dictionary) Anagrams(ctx context.Context, letters string, exact bool) ([]string, error) {
+	available := make(map[rune]int)
+	wildcards, total := 0, 0
+	for _, char := range strings.ToUpper(letters) {
+		switch {
+		case char == ANAGRAM_WILDCARD:
+			wildcards++
+		case char != ' ':
+			available[char]++
+		default:
+			continue
+		}
+		total++
+	}
+
+	var words []string
+	var visited int
+	prefix := make([]rune, 0, total)
+	var walk func(node *TrieNode) error
+	walk = func(node *TrieNode) error {
+		// Verificar o contexto a cada bloco de nós mantém o custo baixo
+		if visited++; visited%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		if node.isWord && (!exact || len(prefix) == total) {
+			words = append(words, string(prefix))
+		}
+		for char, child := range node.children {
+			wildcard := available[char] == 0
+			if wildcard && wildcards == 0 {
+				continue
+			}
+			if wildcard {
+				wildcards--
+			} else {
+				available[char]--
+			}
+			prefix = append(prefix, char)
+			err := walk(child)
+			prefix = prefix[:len(prefix)-1]
+			if wildcard {
+				wildcards++
+			} else {
+				available[char]++
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(d.trie); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(words, func(i, j int) bool {
+		if len(words[i]) != len(words[j]) {
+			return len(words[i]) > len(words[j])
+		}
+		return words[i] < words[j]
+	})
+	return words, nil
+}
@@ -11,20 +11,14 @@ const (
 	BR = "BR"
 )
 
-// slice of directions
-var Directions []string
-
-func NewDirections() *[]string {
-
-	Directions = make([]string, 8)
-	Directions = append(Directions, L)
-	Directions = append(Directions, TL)
-	Directions = append(Directions, T)
-	Directions = append(Directions, TR)
-	Directions = append(Directions, R)
-	Directions = append(Directions, BR)
-	Directions = append(Directions, B)
-	Directions = append(Directions, BL)
-
-	return &Directions
+// lineDirections são as 8 direções da vizinhança king, compartilhadas pelo caminhamento e pela busca em linha reta
+var lineDirections = []Direction{
+	{R, "→", 0, 1},
+	{L, "←", 0, -1},
+	{B, "↓", 1, 0},
+	{T, "↑", -1, 0},
+	{BR, "↘", 1, 1},
+	{BL, "↙", 1, -1},
+	{TR, "↗", -1, 1},
+	{TL, "↖", -1, -1},
 }
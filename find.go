@@ -0,0 +1,140 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// WILDCARD_TILE é a peça coringa da matriz: com FindOptions.Wildcards, vale qualquer letra
+const WILDCARD_TILE = '?'
+
+// DEFAULT_FIND_LIMIT limita os caminhos por palavra no subcomando find
+const DEFAULT_FIND_LIMIT = 100
+
+// FindOptions ajusta a busca direcionada de FindWord
+type FindOptions struct {
+	// Wildcards faz as peças WILDCARD_TILE valerem qualquer letra
+	Wildcards bool
+	// Limit interrompe a busca após Limit caminhos (0 retorna todos)
+	Limit int
+}
+
+// FindWord retorna todos os caminhos que formam a palavra na matriz, seguindo a adjacência da
+// matriz e sem repetir células. Não usa dicionário: a busca parte só das células com a primeira letra.
+func (lm *LetterMatrix) FindWord(word string, options FindOptions) []PathResult {
+	target := []rune(normalizeTarget(word))
+	if len(target) == 0 {
+		return nil
+	}
+
+	finder := wordFinder{matrix: lm, target: target, options: options, path: make([]Coord, 0, len(target))}
+	for row := 0; row < lm.rows; row++ {
+		for col := 0; col < lm.cols; col++ {
+			if !finder.find(Coord{X: row, Y: col}) {
+				return finder.found
+			}
+		}
+	}
+	return finder.found
+}
+
+// wordFinder guarda o estado da busca em profundidade de FindWord
+type wordFinder struct {
+	matrix  *LetterMatrix
+	target  []rune
+	options FindOptions
+	path    []Coord
+	found   []PathResult
+}
+
+func (wf *wordFinder) matches(cell rune, char rune) bool {
+	if !isLetter(cell) {
+		return false
+	}
+	return unicode.ToUpper(cell) == char || (wf.options.Wildcards && cell == WILDCARD_TILE)
+}
+
+// find estende o caminho até a célula; retorna false quando o limite de caminhos é atingido
+func (wf *wordFinder) find(cell Coord) bool {
+	if !wf.matches(wf.matrix.matrix[cell.X][cell.Y], wf.target[len(wf.path)]) || containsCoord(wf.path, cell) {
+		return true
+	}
+	wf.path = append(wf.path, cell)
+	defer func() { wf.path = wf.path[:len(wf.path)-1] }()
+
+	if len(wf.path) == len(wf.target) {
+		wf.found = append(wf.found, PathResult{Word: string(wf.target), Coordinates: append([]Coord(nil), wf.path...)})
+		return wf.options.Limit <= 0 || len(wf.found) < wf.options.Limit
+	}
+
+	var buffer [8]Coord
+	for _, next := range wf.matrix.GetAdjacency().Neighbors(buffer[:0], cell, wf.matrix.rows, wf.matrix.cols) {
+		if !wf.find(next) {
+			return false
+		}
+	}
+	return true
+}
+
+// printFindResults imprime os caminhos de cada palavra; retorna quantas não foram encontradas
+func printFindResults(w io.Writer, words []string, matrix *LetterMatrix, options FindOptions, scorer Scorer) int {
+	missing := 0
+	for _, word := range words {
+		paths := matrix.FindWord(word, options)
+		if len(paths) == 0 {
+			missing++
+			fmt.Fprintf(w, "%s: não encontrada\n", normalizeTarget(word))
+			continue
+		}
+		fmt.Fprintf(w, "%s: %d caminho(s)\n", paths[0].Word, len(paths))
+		for _, path := range paths {
+			score := scorer.Score(path.Word, path.Coordinates, matrix)
+			fmt.Fprintf(w, "  %s  %d pts", path.Path(), score.Total)
+			// Marca as peças coringa usadas, que valem zero nas regras por letra
+			var wildcards []Coord
+			for _, coord := range path.Coordinates {
+				if matrix.matrix[coord.X][coord.Y] == WILDCARD_TILE {
+					wildcards = append(wildcards, coord)
+				}
+			}
+			if len(wildcards) > 0 {
+				fmt.Fprintf(w, "  coringa em %s", PathResult{Coordinates: wildcards}.Path())
+			}
+			fmt.Fprintln(w)
+		}
+	}
+	return missing
+}
+
+// runFind implementa o subcomando "wordgo find PALAVRA..."
+func runFind(args []string) {
+	flags := flag.NewFlagSet("find", flag.ExitOnError)
+	matrixFile := flags.String("matrix", "res/example.txt", "Arquivo de matriz de letras para carregar (\"-\" lê da entrada padrão)")
+	grid := flags.String("grid", "", "Matriz em linha com as linhas separadas por '/', ex: \"abc/def/ghi\"")
+	rules := flags.String("rules", DEFAULT_RULES, "Regras de pontuação: "+strings.Join(RuleSetNames(), ", "))
+	wildcards := flags.Bool("wildcards", false, fmt.Sprintf("Peças '%c' da matriz valem qualquer letra", WILDCARD_TILE))
+	limit := flags.Int("limit", DEFAULT_FIND_LIMIT, "Máximo de caminhos por palavra (0 mostra todos)")
+	flags.Parse(args)
+
+	words := flags.Args()
+	if len(words) == 0 {
+		log.Fatal("Informe as palavras: wordgo find [flags] PALAVRA...")
+	}
+	boards, err := loadBoards(*matrixFile, *grid, false)
+	if err != nil {
+		log.Fatalf("Erro ao carregar matriz: %v", err)
+	}
+	scorer, err := NewScorer(*rules)
+	if err != nil {
+		log.Fatalf("Erro ao selecionar regras: %v", err)
+	}
+
+	if missing := printFindResults(os.Stdout, words, boards[0], FindOptions{Wildcards: *wildcards, Limit: *limit}, scorer); missing > 0 {
+		os.Exit(1)
+	}
+}
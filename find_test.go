@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestFindWord tests locating every path of a word without a dictionary
+func TestFindWord(t *testing.T) {
+	matrix, err := NewLetterMatrixFromGrid("plan/xten/xxet")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+
+	paths := matrix.FindWord("planet", FindOptions{})
+	got := make([]string, len(paths))
+	for i, path := range paths {
+		got[i] = path.String()
+	}
+	expected := []string{
+		"PLANET (1,1)(1,2)(1,3)(1,4)(2,3)(2,2)",
+		"PLANET (1,1)(1,2)(1,3)(1,4)(2,3)(3,4)",
+		"PLANET (1,1)(1,2)(1,3)(2,4)(2,3)(2,2)",
+		"PLANET (1,1)(1,2)(1,3)(2,4)(2,3)(3,4)",
+		"PLANET (1,1)(1,2)(1,3)(2,4)(3,3)(3,4)",
+		"PLANET (1,1)(1,2)(1,3)(2,4)(3,3)(2,2)",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected paths:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(got, "\n"))
+	}
+
+	if limited := matrix.FindWord("PLANET", FindOptions{Limit: 2}); len(limited) != 2 {
+		t.Errorf("Expected the limit to stop at 2 paths, got %d", len(limited))
+	}
+	if missing := matrix.FindWord("PLANETS", FindOptions{}); len(missing) != 0 {
+		t.Errorf("Expected no paths for PLANETS, got %v", missing)
+	}
+}
+
+// TestFindWordWildcards tests that wildcard tiles match any letter only when enabled
+func TestFindWordWildcards(t *testing.T) {
+	matrix, err := NewLetterMatrixFromGrid("pl?net")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	if paths := matrix.FindWord("PLANET", FindOptions{}); len(paths) != 0 {
+		t.Errorf("Expected no paths without wildcards, got %v", paths)
+	}
+	paths := matrix.FindWord("PLANET", FindOptions{Wildcards: true})
+	if len(paths) != 1 || paths[0].Path() != "(1,1)(1,2)(1,3)(1,4)(1,5)(1,6)" {
+		t.Errorf("Expected PLANET through the wildcard tile, got %v", paths)
+	}
+}
+
+// TestFindWordAdjacency tests that the search follows the board topology and mask
+func TestFindWordAdjacency(t *testing.T) {
+	matrix, err := parseBoard([]string{"---", "adjacency: orthogonal+wrap", "---", "anetpl", "######"})
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	paths := matrix.FindWord("planet", FindOptions{})
+	if len(paths) != 1 || paths[0].Path() != "(1,5)(1,6)(1,1)(1,2)(1,3)(1,4)" {
+		t.Errorf("Expected PLANET across the border, got %v", paths)
+	}
+}
+
+// TestPrintFindResults tests the report and the count of missing words
+func TestPrintFindResults(t *testing.T) {
+	matrix, _ := NewLetterMatrixFromGrid("planet")
+	scorer, _ := NewScorer(DEFAULT_RULES)
+
+	var out bytes.Buffer
+	missing := printFindResults(&out, []string{"planet", "plants"}, matrix, FindOptions{}, scorer)
+	if missing != 1 {
+		t.Errorf("Expected 1 missing word, got %d", missing)
+	}
+	expected := "PLANET: 1 caminho(s)\n  (1,1)(1,2)(1,3)(1,4)(1,5)(1,6)  6 pts\nPLANTS: não encontrada\n"
+	if out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+}
+
+// TestPrintFindResultsWildcard tests that wildcard tiles score zero and are marked in the report
+func TestPrintFindResultsWildcard(t *testing.T) {
+	matrix, _ := NewLetterMatrixFromGrid("pl?net")
+	scorer, _ := NewScorer("scrabble")
+
+	var out bytes.Buffer
+	printFindResults(&out, []string{"planet"}, matrix, FindOptions{Wildcards: true}, scorer)
+	// P3 L1 N1 E1 T1, with the blank A worth nothing
+	expected := "PLANET: 1 caminho(s)\n  (1,1)(1,2)(1,3)(1,4)(1,5)(1,6)  7 pts  coringa em (1,3)\n"
+	if out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrCannotPlace     = errors.New("não foi possível posicionar todas as palavras")
+	ErrUnintendedWords = errors.New("palavras não intencionais na matriz")
+	ErrTargetNotUnique = errors.New("palavras da lista sem ocorrência única na matriz")
+	ErrInvalidSize     = errors.New("tamanho inválido")
+)
+
+// Limites de tentativas do gerador de caça-palavras
+const (
+	GENERATE_PLACE_ATTEMPTS = 200
+	GENERATE_LAYOUTS        = 50
+	GENERATE_FILLS          = 50
+	GENERATE_CANDIDATES     = 20
+)
+
+// WordPlacement é uma palavra posicionada pelo gerador
+type WordPlacement struct {
+	Word     string
+	Location WordLocation
+}
+
+// GeneratedWordSearch é um caça-palavras gerado com as posições das palavras
+type GeneratedWordSearch struct {
+	Matrix     *LetterMatrix
+	Placements []WordPlacement
+}
+
+// WordSearchGenerator gera caça-palavras reproduzíveis pela semente
+type WordSearchGenerator struct {
+	rows         int
+	cols         int
+	directions   []Direction
+	distribution *LetterDistribution
+	// dictionary e checkLength ativam a verificação de palavras não intencionais
+	dictionary  *Dictionary
+	checkLength int
+	rng         *rand.Rand
+}
+
+// NewWordSearchGenerator cria um gerador com as direções permitidas para as palavras
+func NewWordSearchGenerator(rows, cols int, directions []Direction, seed uint64) *WordSearchGenerator {
+	return &WordSearchGenerator{
+		rows:         rows,
+		cols:         cols,
+		directions:   directions,
+		distribution: EnglishLetters,
+		rng:          rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// SetVerification rejeita grades com palavras do dicionário de checkLength+ letras fora da lista.
+// O dicionário só guarda palavras de MIN_WORD_LENGTH+ letras, então valores menores são elevados a ele.
+func (g *WordSearchGenerator) SetVerification(dictionary *Dictionary, checkLength int) {
+	g.dictionary = dictionary
+	g.checkLength = max(checkLength, MIN_WORD_LENGTH)
+}
+
+// Generate posiciona as palavras (mais longas primeiro, preferindo sobreposição) e preenche o resto
+func (g *WordSearchGenerator) Generate(words []string) (*GeneratedWordSearch, error) {
+	targets := make([]string, 0, len(words))
+	for _, word := range words {
+		if normalized := normalizeTarget(word); normalized != "" {
+			if len(normalized) > max(g.rows, g.cols) {
+				return nil, fmt.Errorf("%w: %s não cabe em %dx%d", ErrCannotPlace, normalized, g.rows, g.cols)
+			}
+			targets = append(targets, normalized)
+		}
+	}
+	sort.SliceStable(targets, func(i, j int) bool { return len(targets[i]) > len(targets[j]) })
+
+	var lastErr error = ErrCannotPlace
+	for range GENERATE_LAYOUTS {
+		grid, placements, ok := g.layout(targets)
+		if !ok {
+			continue
+		}
+		for range GENERATE_FILLS {
+			generated := g.fill(grid, placements)
+			if err := g.verify(generated, targets); err != nil {
+				lastErr = err
+				continue
+			}
+			return generated, nil
+		}
+	}
+	return nil, lastErr
+}
+
+// layout tenta posicionar todas as palavras numa grade vazia
+func (g *WordSearchGenerator) layout(targets []string) ([][]rune, []WordPlacement, bool) {
+	grid := make([][]rune, g.rows)
+	for i := range grid {
+		grid[i] = make([]rune, g.cols)
+	}
+
+	placements := make([]WordPlacement, 0, len(targets))
+	for _, target := range targets {
+		word := []rune(target)
+		best, bestOverlap, candidates := WordLocation{}, -1, 0
+		for attempt := 0; attempt < GENERATE_PLACE_ATTEMPTS && candidates < GENERATE_CANDIDATES; attempt++ {
+			direction := g.directions[g.rng.IntN(len(g.directions))]
+			row, col := g.rng.IntN(g.rows), g.rng.IntN(g.cols)
+			overlap, path, ok := g.fits(grid, word, row, col, direction)
+			if !ok {
+				continue
+			}
+			candidates++
+			if overlap > bestOverlap {
+				bestOverlap = overlap
+				best = WordLocation{Start: path[0], End: path[len(path)-1], Direction: direction, Path: path}
+			}
+		}
+		if bestOverlap < 0 {
+			return nil, nil, false
+		}
+		for i, coord := range best.Path {
+			grid[coord.X][coord.Y] = word[i]
+		}
+		placements = append(placements, WordPlacement{Word: target, Location: best})
+	}
+	return grid, placements, true
+}
+
+// fits verifica se a palavra cabe na posição, retornando quantas letras são compartilhadas
+func (g *WordSearchGenerator) fits(grid [][]rune, word []rune, row, col int, direction Direction) (int, []Coord, bool) {
+	overlap := 0
+	path := make([]Coord, 0, len(word))
+	for _, char := range word {
+		if row < 0 || row >= g.rows || col < 0 || col >= g.cols {
+			return 0, nil, false
+		}
+		switch grid[row][col] {
+		case 0:
+		case char:
+			overlap++
+		default:
+			return 0, nil, false
+		}
+		path = append(path, Coord{X: row, Y: col})
+		row += direction.DeltaRow
+		col += direction.DeltaCol
+	}
+	// Uma palavra inteiramente sobreposta a outra não seria distinguível
+	return overlap, path, overlap < len(word)
+}
+
+// fill completa as células vazias com letras sorteadas pela distribuição
+func (g *WordSearchGenerator) fill(grid [][]rune, placements []WordPlacement) *GeneratedWordSearch {
+	lines := make([]string, g.rows)
+	for i, row := range grid {
+		filled := make([]rune, g.cols)
+		for j, char := range row {
+			if char == 0 {
+				char = g.distribution.Pick(g.rng)
+			}
+			filled[j] = unicode.ToLower(char)
+		}
+		lines[i] = string(filled)
+	}
+	matrix, _ := NewLetterMatrixFromString(strings.Join(lines, "\n"))
+	return &GeneratedWordSearch{Matrix: matrix, Placements: placements}
+}
+
+// verify garante que cada palavra da lista aparece uma única vez, como o solve vai procurá-la,
+// e, com a verificação ligada, usa o buscador simples para garantir que só elas aparecem
+func (g *WordSearchGenerator) verify(generated *GeneratedWordSearch, targets []string) error {
+	// A verificação pelo dicionário não vê palavras curtas nem fora dele, como "SOL" repetido
+	var repeated []string
+	for _, result := range NewWordSimpleSearcher(generated.Matrix, nil).FindTargets(targets) {
+		if result.Status != TargetFound {
+			repeated = append(repeated, result.Word)
+		}
+	}
+	if len(repeated) > 0 {
+		return fmt.Errorf("%w: %s", ErrTargetNotUnique, strings.Join(repeated, ", "))
+	}
+	if g.dictionary == nil {
+		return nil
+	}
+
+	placed := make(map[Coord][]int)
+	for i, placement := range generated.Placements {
+		for _, coord := range placement.Location.Path {
+			placed[coord] = append(placed[coord], i)
+		}
+	}
+
+	searcher := NewWordSimpleSearcher(generated.Matrix, g.dictionary)
+	searcher.SearchAllWords(4)
+	var unintended []string
+	for _, result := range searcher.GetResults() {
+		if result.Length < g.checkLength || insidePlacement(result.Path, placed) {
+			continue
+		}
+		unintended = append(unintended, result.Word)
+	}
+	if len(unintended) > 0 {
+		return fmt.Errorf("%w: %s", ErrUnintendedWords, strings.Join(unintended, ", "))
+	}
+	return nil
+}
+
+// insidePlacement indica se todas as células do caminho pertencem a uma mesma palavra posicionada
+func insidePlacement(path []Coord, placed map[Coord][]int) bool {
+	for _, candidate := range placed[path[0]] {
+		inside := true
+		for _, coord := range path[1:] {
+			found := false
+			for _, index := range placed[coord] {
+				found = found || index == candidate
+			}
+			if !found {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDirections converte nomes como "R,B,BR" em direções da busca simples; "all" usa as 8
+func parseDirections(spec string) ([]Direction, error) {
+	if spec == "" || strings.EqualFold(spec, "all") {
+		return lineDirections, nil
+	}
+	var directions []Direction
+	for _, name := range strings.Split(strings.ToUpper(spec), ",") {
+		found := false
+		for _, direction := range lineDirections {
+			if direction.Name == strings.TrimSpace(name) {
+				directions = append(directions, direction)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("direção desconhecida: %q", name)
+		}
+	}
+	return directions, nil
+}
+
+// parseSize interpreta tamanhos como "12x15" ou "12"
+func parseSize(spec string) (int, int, error) {
+	rowsSpec, colsSpec, found := strings.Cut(strings.ToLower(spec), "x")
+	if !found {
+		colsSpec = rowsSpec
+	}
+	rows, errRows := strconv.Atoi(rowsSpec)
+	cols, errCols := strconv.Atoi(colsSpec)
+	if errRows != nil || errCols != nil || rows <= 0 || cols <= 0 {
+		return 0, 0, fmt.Errorf("%w: %q", ErrInvalidSize, spec)
+	}
+	return rows, cols, nil
+}
+
+// runGenerate implementa o subcomando "wordgo generate <tipo>"
+func runGenerate(args []string) {
+	if len(args) == 0 {
+		log.Fatal("Uso: wordgo generate wordsearch|boggle|tower [opções]")
+	}
+	switch args[0] {
+	case "wordsearch":
+		runGenerateWordSearch(args[1:])
+	case "boggle":
+		runGenerateBoggle(args[1:])
+	case "tower":
+		runGenerateTower(args[1:])
+	default:
+		log.Fatalf("Tipo de geração desconhecido: %s", args[0])
+	}
+}
+
+func runGenerateWordSearch(args []string) {
+	flags := flag.NewFlagSet("generate wordsearch", flag.ExitOnError)
+	words := flags.String("words", "", "Arquivo com as palavras (uma por linha) ou lista separada por vírgulas")
+	size := flags.String("size", "12x12", "Tamanho da grade (linhasxcolunas)")
+	dirs := flags.String("dirs", "all", "Direções permitidas, ex: R,B,BR")
+	seed := flags.Uint64("seed", 1, "Semente do gerador")
+	dictFile := flags.String("dict", DEFAULT_DICTIONARY, "Dicionário para verificar palavras não intencionais (vazio desativa)")
+	checkLength := flags.Int("check", MIN_WORD_LENGTH, fmt.Sprintf("Comprimento mínimo das palavras não intencionais verificadas (no mínimo %d)", MIN_WORD_LENGTH))
+	output := flags.String("out", "", "Arquivo de saída da grade")
+	flags.Parse(args)
+
+	if *words == "" {
+		log.Fatal("Informe as palavras com -words")
+	}
+	if *checkLength < MIN_WORD_LENGTH {
+		log.Fatalf("-check deve ser no mínimo %d: o dicionário não guarda palavras menores", MIN_WORD_LENGTH)
+	}
+	targets, err := readTargets(*words)
+	if err != nil {
+		log.Fatalf("Erro ao carregar palavras: %v", err)
+	}
+	rows, cols, err := parseSize(*size)
+	if err != nil {
+		log.Fatal(err)
+	}
+	directions, err := parseDirections(*dirs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	generator := NewWordSearchGenerator(rows, cols, directions, *seed)
+	if *dictFile != "" {
+		dict, err := NewDictionary(*dictFile)
+		if err != nil {
+			log.Fatalf("Erro ao carregar dicionário: %v", err)
+		}
+		generator.SetVerification(dict, *checkLength)
+	}
+
+	generated, err := generator.Generate(targets)
+	if err != nil {
+		log.Fatalf("Erro ao gerar caça-palavras: %v", err)
+	}
+
+	fmt.Println(generated.Matrix)
+	fmt.Println()
+	for _, placement := range generated.Placements {
+		location := placement.Location
+		fmt.Printf("%-16s (%d,%d)%s(%d,%d)\n", placement.Word, location.Start.X+1, location.Start.Y+1,
+			location.Direction.Symbol, location.End.X+1, location.End.Y+1)
+	}
+	if *output != "" {
+		if err := writeBoardFile(*output, generated.Matrix, BoardMeta{Game: GameWordSearch}); err != nil {
+			log.Fatalf("Erro ao gravar %s: %v", *output, err)
+		}
+	}
+}
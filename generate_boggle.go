@@ -0,0 +1,252 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"unicode"
+)
+
+var ErrUnknownDice = errors.New("conjunto de dados desconhecido")
+
+// DEFAULT_BOGGLE_ITERATIONS é o número padrão de passos do recozimento simulado
+const DEFAULT_BOGGLE_ITERATIONS = 2000
+
+// diceSets são os dados do Boggle; a face "Qu" é representada apenas por Q
+var diceSets = map[string][]string{
+	"classic": {
+		"AAEEGN", "ABBJOO", "ACHOPS", "AFFKPS", "AOOTTW", "CIMOTU", "DEILRX", "DELRVY",
+		"DISTTY", "EEGHNW", "EEINSU", "EHRTVW", "EIOSST", "ELRTTY", "HIMNQU", "HLNNRZ",
+	},
+	"big": {
+		"AAAFRS", "AAEEEE", "AAFIRS", "ADENNN", "AEEEEM", "AEEGMU", "AEGMNN", "AFIRSY",
+		"BJKQXZ", "CCENST", "CEIILT", "CEILPT", "CEIPST", "DDHNOT", "DHHLOR", "DHLNOR",
+		"DHLNOR", "EIIITT", "EMOTTT", "ENSSSU", "FIPRSY", "GORRVW", "IPRRRY", "NOOTUW",
+		"OOOTTU",
+	},
+}
+
+// BoggleTargets são as metas de qualidade da matriz gerada
+type BoggleTargets struct {
+	MinWords    int
+	MinLongest  int
+	MinCoverage float64
+}
+
+// BoggleStats são as métricas de uma matriz, obtidas pela busca por caminhos
+type BoggleStats struct {
+	Words    int
+	Longest  string
+	Coverage float64
+}
+
+// GeneratedBoggle é a melhor matriz encontrada pelo gerador
+type GeneratedBoggle struct {
+	Matrix     *LetterMatrix
+	Stats      BoggleStats
+	Iterations int
+	MetTargets bool
+}
+
+// BoggleGenerator gera matrizes de caminhamento livre com recozimento simulado
+type BoggleGenerator struct {
+	rows         int
+	cols         int
+	dice         []string
+	distribution *LetterDistribution
+	dictionary   *Dictionary
+	rng          *rand.Rand
+}
+
+// NewBoggleGenerator cria um gerador; dice vazio usa a distribuição de letras do inglês
+func NewBoggleGenerator(rows, cols int, dice string, dictionary *Dictionary, seed uint64) (*BoggleGenerator, error) {
+	generator := &BoggleGenerator{
+		rows:         rows,
+		cols:         cols,
+		distribution: EnglishLetters,
+		dictionary:   dictionary,
+		rng:          rand.New(rand.NewPCG(seed, seed)),
+	}
+	if dice == "" || dice == "letters" {
+		return generator, nil
+	}
+	set, ok := diceSets[dice]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownDice, dice)
+	}
+	if len(set) != rows*cols {
+		return nil, fmt.Errorf("%w: %q tem %d dados para %dx%d", ErrInvalidSize, dice, len(set), rows, cols)
+	}
+	generator.dice = set
+	return generator, nil
+}
+
+// boggleBoard guarda qual dado ocupa cada célula e a face sorteada
+type boggleBoard struct {
+	dice    []int
+	letters []rune
+}
+
+// Generate busca uma matriz que atinja as metas em até iterations passos
+func (g *BoggleGenerator) Generate(targets BoggleTargets, iterations int) *GeneratedBoggle {
+	current := g.roll()
+	currentStats := g.evaluate(current)
+	currentEnergy := targets.energy(currentStats)
+	best := &GeneratedBoggle{Matrix: g.matrix(current), Stats: currentStats, MetTargets: currentEnergy == 0}
+	bestEnergy := currentEnergy
+
+	temperature := 1.0
+	for iteration := 1; iteration <= iterations && !best.MetTargets; iteration++ {
+		best.Iterations = iteration
+		candidate := g.mutate(current)
+		stats := g.evaluate(candidate)
+		energy := targets.energy(stats)
+
+		if energy <= currentEnergy || g.rng.Float64() < math.Exp((currentEnergy-energy)/temperature) {
+			current, currentStats, currentEnergy = candidate, stats, energy
+			if energy < bestEnergy {
+				best.Matrix, best.Stats, best.MetTargets = g.matrix(current), currentStats, energy == 0
+				bestEnergy = energy
+			}
+		}
+		temperature = max(temperature*0.995, 0.01)
+	}
+	return best
+}
+
+// energy mede o quanto as métricas ficam abaixo das metas; zero significa metas atingidas
+func (t BoggleTargets) energy(stats BoggleStats) float64 {
+	energy := 0.0
+	if t.MinWords > 0 && stats.Words < t.MinWords {
+		energy += float64(t.MinWords-stats.Words) / float64(t.MinWords)
+	}
+	if t.MinLongest > 0 && len(stats.Longest) < t.MinLongest {
+		energy += float64(t.MinLongest-len(stats.Longest)) / float64(t.MinLongest)
+	}
+	if t.MinCoverage > 0 && stats.Coverage < t.MinCoverage {
+		energy += (t.MinCoverage - stats.Coverage) / t.MinCoverage
+	}
+	return energy
+}
+
+// roll embaralha os dados (ou sorteia letras) para uma matriz inicial
+func (g *BoggleGenerator) roll() boggleBoard {
+	size := g.rows * g.cols
+	board := boggleBoard{dice: make([]int, size), letters: make([]rune, size)}
+	if g.dice != nil {
+		copy(board.dice, g.rng.Perm(size))
+	}
+	for i := range board.letters {
+		board.letters[i] = g.face(board.dice[i])
+	}
+	return board
+}
+
+// face sorteia a face de um dado ou uma letra da distribuição
+func (g *BoggleGenerator) face(die int) rune {
+	if g.dice == nil {
+		return g.distribution.Pick(g.rng)
+	}
+	faces := g.dice[die]
+	return rune(faces[g.rng.IntN(len(faces))])
+}
+
+// mutate troca dois dados de lugar ou sorteia novamente uma célula
+func (g *BoggleGenerator) mutate(board boggleBoard) boggleBoard {
+	next := boggleBoard{dice: append([]int(nil), board.dice...), letters: append([]rune(nil), board.letters...)}
+	i := g.rng.IntN(len(next.letters))
+	if g.dice != nil && g.rng.IntN(2) == 0 {
+		j := g.rng.IntN(len(next.letters))
+		next.dice[i], next.dice[j] = next.dice[j], next.dice[i]
+		next.letters[i], next.letters[j] = next.letters[j], next.letters[i]
+		return next
+	}
+	next.letters[i] = g.face(next.dice[i])
+	return next
+}
+
+func (g *BoggleGenerator) matrix(board boggleBoard) *LetterMatrix {
+	lines := make([]string, g.rows)
+	for i := range lines {
+		line := make([]rune, g.cols)
+		for j := range line {
+			line[j] = unicode.ToLower(board.letters[i*g.cols+j])
+		}
+		lines[i] = string(line)
+	}
+	matrix, _ := NewLetterMatrixFromString(strings.Join(lines, "\n"))
+	return matrix
+}
+
+// evaluate usa a busca por caminhos para medir a matriz
+func (g *BoggleGenerator) evaluate(board boggleBoard) BoggleStats {
+	return measureBoggle(g.matrix(board), g.dictionary)
+}
+
+// measureBoggle conta palavras distintas, a mais longa e a fração de células usadas
+func measureBoggle(matrix *LetterMatrix, dictionary *Dictionary) BoggleStats {
+	rows, cols := matrix.GetDimensions()
+	used := newBoolGrid(rows, cols)
+	words := make(map[string]bool)
+	stats := BoggleStats{}
+	covered := 0
+
+	for _, result := range NewPathSearcher(matrix, dictionary).SearchAllWords() {
+		words[result.Word] = true
+		if len(result.Word) > len(stats.Longest) || (len(result.Word) == len(stats.Longest) && result.Word < stats.Longest) {
+			stats.Longest = result.Word
+		}
+		for _, coord := range result.Coordinates {
+			if !used[coord.X][coord.Y] {
+				used[coord.X][coord.Y] = true
+				covered++
+			}
+		}
+	}
+	stats.Words = len(words)
+	stats.Coverage = float64(covered) / float64(rows*cols)
+	return stats
+}
+
+func runGenerateBoggle(args []string) {
+	flags := flag.NewFlagSet("generate boggle", flag.ExitOnError)
+	size := flags.String("size", "4x4", "Tamanho da matriz (linhasxcolunas)")
+	dice := flags.String("dice", "classic", "Dados: classic (4x4), big (5x5) ou letters (distribuição do inglês)")
+	seed := flags.Uint64("seed", 1, "Semente do gerador")
+	dictFile := flags.String("dict", DEFAULT_DICTIONARY, "Arquivo de dicionário")
+	minWords := flags.Int("min-words", 20, "Mínimo de palavras distintas")
+	minLongest := flags.Int("min-longest", 8, "Comprimento mínimo da palavra mais longa")
+	minCoverage := flags.Float64("min-coverage", 0.75, "Fração mínima de células usadas por alguma palavra")
+	iterations := flags.Int("iterations", DEFAULT_BOGGLE_ITERATIONS, "Máximo de passos de otimização")
+	output := flags.String("out", "", "Arquivo de saída da matriz")
+	flags.Parse(args)
+
+	rows, cols, err := parseSize(*size)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dict, err := NewDictionary(*dictFile)
+	if err != nil {
+		log.Fatalf("Erro ao carregar dicionário: %v", err)
+	}
+	generator, err := NewBoggleGenerator(rows, cols, *dice, dict, *seed)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	generated := generator.Generate(BoggleTargets{MinWords: *minWords, MinLongest: *minLongest, MinCoverage: *minCoverage}, *iterations)
+	fmt.Println(generated.Matrix)
+	fmt.Println()
+	fmt.Printf("Palavras: %d  Mais longa: %s (%d)  Cobertura: %.0f%%  Iterações: %d  Metas atingidas: %t\n",
+		generated.Stats.Words, generated.Stats.Longest, len(generated.Stats.Longest),
+		generated.Stats.Coverage*100, generated.Iterations, generated.MetTargets)
+	if *output != "" {
+		if err := writeBoardFile(*output, generated.Matrix, BoardMeta{Game: GameBoggle}); err != nil {
+			log.Fatalf("Erro ao gravar %s: %v", *output, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestBoggleGeneratorTargets tests that annealing reaches the quality targets reproducibly
+func TestBoggleGeneratorTargets(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLATEN", "PLANETS")
+	targets := BoggleTargets{MinWords: 2, MinLongest: 6, MinCoverage: 0.5}
+
+	generate := func() *GeneratedBoggle {
+		generator, err := NewBoggleGenerator(3, 3, "letters", dict, 7)
+		if err != nil {
+			t.Fatalf("NewBoggleGenerator failed: %v", err)
+		}
+		generator.distribution = NewLetterDistribution(map[rune]int{'P': 1, 'L': 1, 'A': 1, 'N': 1, 'E': 1, 'T': 1, 'S': 1})
+		return generator.Generate(targets, 2000)
+	}
+
+	generated := generate()
+	if !generated.MetTargets {
+		t.Fatalf("Expected targets to be met, got %+v", generated.Stats)
+	}
+	if stats := measureBoggle(generated.Matrix, dict); stats != generated.Stats {
+		t.Errorf("Reported stats %+v differ from measured %+v", generated.Stats, stats)
+	}
+	if generated.Stats.Words < 2 || len(generated.Stats.Longest) < 6 || generated.Stats.Coverage < 0.5 {
+		t.Errorf("Stats below targets: %+v", generated.Stats)
+	}
+	if again := generate(); again.Matrix.String() != generated.Matrix.String() {
+		t.Errorf("Expected the same board for the same seed, got %q and %q", generated.Matrix, again.Matrix)
+	}
+}
+
+// TestBoggleGeneratorDice tests dice set validation and rolling
+func TestBoggleGeneratorDice(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	if _, err := NewBoggleGenerator(4, 4, "poker", dict, 1); !errors.Is(err, ErrUnknownDice) {
+		t.Errorf("Expected ErrUnknownDice, got %v", err)
+	}
+	if _, err := NewBoggleGenerator(4, 4, "big", dict, 1); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize, got %v", err)
+	}
+
+	generator, err := NewBoggleGenerator(4, 4, "classic", dict, 1)
+	if err != nil {
+		t.Fatalf("NewBoggleGenerator failed: %v", err)
+	}
+	board := generator.roll()
+	used := make(map[int]bool)
+	for i, die := range board.dice {
+		used[die] = true
+		found := false
+		for _, face := range diceSets["classic"][die] {
+			found = found || face == board.letters[i]
+		}
+		if !found {
+			t.Errorf("Letter %c is not a face of die %s", board.letters[i], diceSets["classic"][die])
+		}
+	}
+	if len(used) != 16 {
+		t.Errorf("Expected each die used once, got %d distinct dice", len(used))
+	}
+}
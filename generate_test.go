@@ -0,0 +1,91 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestWordSearchGenerator tests that placed words are found by the solver
+func TestWordSearchGenerator(t *testing.T) {
+	words := []string{"planet", "stream", "garden", "rocket"}
+	directions, err := parseDirections("R,B,BR")
+	if err != nil {
+		t.Fatalf("parseDirections failed: %v", err)
+	}
+
+	generator := NewWordSearchGenerator(8, 8, directions, 42)
+	generator.SetVerification(loadTestDictionary(t, "PLANET", "STREAM", "GARDEN", "ROCKET", "MASTER", "DANGER"), 6)
+	generated, err := generator.Generate(words)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	allowed := map[string]bool{R: true, B: true, BR: true}
+	for _, placement := range generated.Placements {
+		if !allowed[placement.Location.Direction.Name] {
+			t.Errorf("%s placed in disallowed direction %s", placement.Word, placement.Location.Direction.Name)
+		}
+	}
+
+	results := NewWordSimpleSearcher(generated.Matrix, nil).FindTargets(words)
+	for _, result := range results {
+		if result.Status == TargetMissing {
+			t.Errorf("Generated grid misses %s:\n%s", result.Word, generated.Matrix)
+		}
+	}
+
+	again, err := NewWordSearchGenerator(8, 8, directions, 42).Generate(words)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	first, _ := NewWordSearchGenerator(8, 8, directions, 42).Generate(words)
+	if again.Matrix.String() != first.Matrix.String() {
+		t.Error("Expected the same grid for the same seed")
+	}
+}
+
+// TestWordSearchGeneratorErrors tests impossible placements and bad specs
+func TestWordSearchGeneratorErrors(t *testing.T) {
+	if _, err := NewWordSearchGenerator(3, 3, lineDirections, 1).Generate([]string{"planet"}); !errors.Is(err, ErrCannotPlace) {
+		t.Errorf("Expected ErrCannotPlace, got %v", err)
+	}
+	if _, _, err := parseSize("0x4"); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize, got %v", err)
+	}
+	if rows, cols, err := parseSize("5x7"); err != nil || rows != 5 || cols != 7 {
+		t.Errorf("Expected 5x7, got %dx%d (%v)", rows, cols, err)
+	}
+	if _, err := parseDirections("R,UP"); err == nil {
+		t.Error("Expected error for unknown direction")
+	}
+}
+
+// TestSetVerificationClamp tests that a check length below MIN_WORD_LENGTH is raised to it
+func TestSetVerificationClamp(t *testing.T) {
+	generator := NewWordSearchGenerator(8, 8, lineDirections, 1)
+	generator.SetVerification(loadTestDictionary(t, "PLANET"), 3)
+	if generator.checkLength != MIN_WORD_LENGTH {
+		t.Errorf("Expected check length %d, got %d", MIN_WORD_LENGTH, generator.checkLength)
+	}
+}
+
+// TestWordSearchGeneratorUniqueTargets tests that short targets outside the dictionary appear exactly once
+func TestWordSearchGeneratorUniqueTargets(t *testing.T) {
+	words := []string{"sol", "mar", "ceu", "lua"}
+	for seed := range uint64(20) {
+		generated, err := NewWordSearchGenerator(5, 5, lineDirections, seed).Generate(words)
+		if err != nil {
+			t.Fatalf("seed %d: Generate failed: %v", seed, err)
+		}
+		for _, result := range NewWordSimpleSearcher(generated.Matrix, nil).FindTargets(words) {
+			if result.Status != TargetFound {
+				t.Errorf("seed %d: expected %s once, got %s:\n%s", seed, result.Word, result.Status, generated.Matrix)
+			}
+		}
+	}
+
+	// ABC always shows up inside XABCX too, so no grid can pass
+	if _, err := NewWordSearchGenerator(5, 5, lineDirections, 1).Generate([]string{"xabcx", "abc"}); !errors.Is(err, ErrTargetNotUnique) {
+		t.Errorf("Expected ErrTargetNotUnique, got %v", err)
+	}
+}
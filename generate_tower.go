@@ -0,0 +1,285 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidSolution = errors.New("solução não esvazia a matriz")
+
+// Limites do gerador de torres
+const (
+	TOWER_PATH_ATTEMPTS = 500
+	TOWER_WORD_ATTEMPTS = 50
+)
+
+// GeneratedTower é uma matriz do modo torre acompanhada de uma sequência que a esvazia
+type GeneratedTower struct {
+	Matrix   *LetterMatrix
+	Solution []PathResult
+}
+
+// TowerGenerator constrói matrizes de trás para frente, inserindo palavras no inverso de RemoveLetters.
+// Só a gravidade padrão (letras caem para baixo, topo vazio) é suportada.
+type TowerGenerator struct {
+	rows       int
+	cols       int
+	dictionary *Dictionary
+	rng        *rand.Rand
+}
+
+// NewTowerGenerator cria um gerador reproduzível pela semente
+func NewTowerGenerator(rows, cols int, dictionary *Dictionary, seed uint64) *TowerGenerator {
+	return &TowerGenerator{
+		rows:       rows,
+		cols:       cols,
+		dictionary: dictionary,
+		rng:        rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// Generate monta a matriz a partir das palavras na ordem em que devem ser removidas
+func (g *TowerGenerator) Generate(words []string) (*GeneratedTower, error) {
+	state := g.emptyMatrix()
+	solution := make([]PathResult, 0, len(words))
+
+	for i := len(words) - 1; i >= 0; i-- {
+		word := strings.ToUpper(words[i])
+		next, path, ok := g.insert(state, []rune(word))
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrCannotPlace, word)
+		}
+		state = next
+		solution = append(solution, PathResult{Word: word, Coordinates: path})
+	}
+	return g.finish(state, solution)
+}
+
+// GenerateRandom escolhe palavras do dicionário (até maxLength letras) até não caber mais nenhuma ou atingir count
+func (g *TowerGenerator) GenerateRandom(count, maxLength int) (*GeneratedTower, error) {
+	pool := make([]string, 0, len(g.dictionary.words))
+	for word := range g.dictionary.words {
+		if len([]rune(word)) <= maxLength {
+			pool = append(pool, word)
+		}
+	}
+	if len(pool) == 0 {
+		return nil, fmt.Errorf("%w: nenhuma palavra com até %d letras", ErrCannotPlace, maxLength)
+	}
+	// Ordem fixa para que a mesma semente gere a mesma matriz
+	sort.Strings(pool)
+
+	state := g.emptyMatrix()
+	solution := make([]PathResult, 0, count)
+	for len(solution) < count {
+		free := g.rows*g.cols - state.CountLetters()
+		placed := false
+		for range TOWER_WORD_ATTEMPTS {
+			word := pool[g.rng.IntN(len(pool))]
+			if len(word) > free {
+				continue
+			}
+			if next, path, ok := g.insert(state, []rune(word)); ok {
+				state = next
+				solution = append(solution, PathResult{Word: word, Coordinates: path})
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			break
+		}
+	}
+	if len(solution) == 0 {
+		return nil, ErrCannotPlace
+	}
+	return g.finish(state, solution)
+}
+
+// finish inverte a solução para a ordem de remoção e valida com a simulação direta
+func (g *TowerGenerator) finish(matrix *LetterMatrix, inserted []PathResult) (*GeneratedTower, error) {
+	solution := make([]PathResult, len(inserted))
+	for i, move := range inserted {
+		solution[len(inserted)-1-i] = move
+	}
+	generated := &GeneratedTower{Matrix: matrix, Solution: solution}
+	if err := ValidateTowerSolution(matrix, g.dictionary, solution); err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+func (g *TowerGenerator) emptyMatrix() *LetterMatrix {
+	matrix, _ := NewLetterMatrixFromString(strings.TrimSuffix(strings.Repeat(strings.Repeat(" ", g.cols)+"\n", g.rows), "\n"))
+	return matrix
+}
+
+// insert é o inverso de RemoveLetters: escolhe um caminho na matriz resultante e empurra as letras
+// existentes para cima, de modo que remover o caminho devolva exatamente a matriz atual
+func (g *TowerGenerator) insert(state *LetterMatrix, word []rune) (*LetterMatrix, []Coord, bool) {
+	heights := make([]int, g.cols)
+	for j := range heights {
+		for i := g.rows - 1; i >= 0 && state.GetMatrix()[i][j] != ' '; i-- {
+			heights[j]++
+		}
+	}
+
+	for range TOWER_PATH_ATTEMPTS {
+		path := g.randomWalk(len(word), heights)
+		if path == nil || !g.fitsColumns(path, heights) {
+			continue
+		}
+
+		next := state.Clone()
+		inPath := make(map[Coord]rune, len(path))
+		perColumn := make([]int, g.cols)
+		for i, coord := range path {
+			inPath[coord] = unicode.ToLower(word[i])
+			perColumn[coord.Y]++
+		}
+		for j := 0; j < g.cols; j++ {
+			source := g.rows - 1
+			for i := g.rows - 1; i >= g.rows-(heights[j]+perColumn[j]); i-- {
+				if letter, ok := inPath[Coord{X: i, Y: j}]; ok {
+					next.matrix[i][j] = letter
+				} else {
+					next.matrix[i][j] = state.GetMatrix()[source][j]
+					source--
+				}
+			}
+		}
+
+		check := next.Clone()
+		check.RemoveLetters(path)
+		if check.String() == state.String() {
+			return next, path, true
+		}
+	}
+	return nil, nil, false
+}
+
+// fitsColumns verifica se cada coluna comporta as letras inseridas logo acima da pilha existente
+func (g *TowerGenerator) fitsColumns(path []Coord, heights []int) bool {
+	perColumn := make([]int, g.cols)
+	for _, coord := range path {
+		perColumn[coord.Y]++
+	}
+	for _, coord := range path {
+		height := heights[coord.Y] + perColumn[coord.Y]
+		if height > g.rows || coord.X < g.rows-height {
+			return false
+		}
+	}
+	return true
+}
+
+// randomWalk sorteia um caminho de vizinhos sem repetir células, restrito à região alcançável
+func (g *TowerGenerator) randomWalk(length int, heights []int) []Coord {
+	reachable := func(c Coord) bool {
+		return c.X >= 0 && c.X < g.rows && c.Y >= 0 && c.Y < g.cols && c.X >= g.rows-heights[c.Y]-length
+	}
+	start := Coord{X: g.rows - 1 - g.rng.IntN(min(g.rows, length)), Y: g.rng.IntN(g.cols)}
+	if !reachable(start) {
+		return nil
+	}
+
+	walk := Word{coordinates: []Coord{start}}
+	for len(walk.coordinates) < length {
+		last := walk.coordinates[len(walk.coordinates)-1]
+		options := make([]Coord, 0, len(lineDirections))
+		for _, direction := range lineDirections {
+			next := Coord{X: last.X + direction.DeltaRow, Y: last.Y + direction.DeltaCol}
+			if reachable(next) && !walk.hasVisitedCell(next) {
+				options = append(options, next)
+			}
+		}
+		if len(options) == 0 {
+			return nil
+		}
+		walk.coordinates = append(walk.coordinates, options[g.rng.IntN(len(options))])
+	}
+	return walk.coordinates
+}
+
+// ValidateTowerSolution aplica a solução com RemoveLetters e confere que cada jogada forma a
+// palavra do dicionário por células vizinhas e que a matriz termina vazia
+func ValidateTowerSolution(matrix *LetterMatrix, dictionary *Dictionary, solution []PathResult) error {
+	state := matrix.Clone()
+	state.SetGravity(DefaultGravity)
+	rows, cols := state.GetDimensions()
+	for i, move := range solution {
+		walk := Word{}
+		for j, coord := range move.Coordinates {
+			if j > 0 {
+				last := move.Coordinates[j-1]
+				if !IsAdjacent(state.GetAdjacency(), last, coord, rows, cols) || walk.hasVisitedCell(coord) {
+					return fmt.Errorf("%w: jogada %d (%s) não é um caminho válido", ErrInvalidSolution, i+1, move)
+				}
+			}
+			walk.coordinates = append(walk.coordinates, coord)
+			walk.word = append(walk.word, unicode.ToUpper(state.GetMatrix()[coord.X][coord.Y]))
+		}
+		if string(walk.word) != move.Word || !dictionary.IsWord(move.Word) {
+			return fmt.Errorf("%w: jogada %d forma %s, esperado %s", ErrInvalidSolution, i+1, string(walk.word), move.Word)
+		}
+		state.RemoveLetters(move.Coordinates)
+	}
+	if remaining := state.CountLetters(); remaining > 0 {
+		return fmt.Errorf("%w: sobram %d letras", ErrInvalidSolution, remaining)
+	}
+	return nil
+}
+
+func runGenerateTower(args []string) {
+	flags := flag.NewFlagSet("generate tower", flag.ExitOnError)
+	size := flags.String("size", "8x6", "Tamanho da matriz (linhasxcolunas)")
+	words := flags.String("words", "", "Palavras na ordem de remoção (arquivo ou lista separada por vírgulas); vazio sorteia do dicionário")
+	count := flags.Int("count", 8, "Número de palavras sorteadas quando -words não é informado")
+	maxLength := flags.Int("max-length", 8, "Comprimento máximo das palavras sorteadas")
+	seed := flags.Uint64("seed", 1, "Semente do gerador")
+	dictFile := flags.String("dict", DEFAULT_DICTIONARY, "Arquivo de dicionário")
+	output := flags.String("out", "", "Arquivo de saída da matriz")
+	flags.Parse(args)
+
+	rows, cols, err := parseSize(*size)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dict, err := NewDictionary(*dictFile)
+	if err != nil {
+		log.Fatalf("Erro ao carregar dicionário: %v", err)
+	}
+
+	generator := NewTowerGenerator(rows, cols, dict, *seed)
+	var generated *GeneratedTower
+	if *words != "" {
+		targets, err := readTargets(*words)
+		if err != nil {
+			log.Fatalf("Erro ao carregar palavras: %v", err)
+		}
+		generated, err = generator.Generate(targets)
+		if err != nil {
+			log.Fatalf("Erro ao gerar torre: %v", err)
+		}
+	} else if generated, err = generator.GenerateRandom(*count, *maxLength); err != nil {
+		log.Fatalf("Erro ao gerar torre: %v", err)
+	}
+
+	fmt.Println(generated.Matrix)
+	fmt.Println()
+	fmt.Println("Solução:")
+	for i, move := range generated.Solution {
+		fmt.Printf("%2d. %s\n", i+1, move)
+	}
+	if *output != "" {
+		if err := writeBoardFile(*output, generated.Matrix, BoardMeta{Game: GameTower, Gravity: "down"}); err != nil {
+			log.Fatalf("Erro ao gravar %s: %v", *output, err)
+		}
+	}
+}
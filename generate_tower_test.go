@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestTowerGenerator tests that generated boards clear with their shipped solution
+func TestTowerGenerator(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "STREAM", "GARDEN", "ROCKET")
+	words := []string{"planet", "stream", "garden", "rocket"}
+
+	generated, err := NewTowerGenerator(5, 5, dict, 3).Generate(words)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if generated.Matrix.CountLetters() != 24 {
+		t.Errorf("Expected 24 letters, got %d:\n%s", generated.Matrix.CountLetters(), generated.Matrix)
+	}
+	for i, move := range generated.Solution {
+		if move.Word != []string{"PLANET", "STREAM", "GARDEN", "ROCKET"}[i] {
+			t.Errorf("Expected solution in the given order, got %v", generated.Solution)
+		}
+	}
+	if err := ValidateTowerSolution(generated.Matrix, dict, generated.Solution); err != nil {
+		t.Errorf("Solution does not clear the board: %v", err)
+	}
+
+	// The first move must be available to the forward path search
+	found := false
+	for _, result := range NewPathSearcher(generated.Matrix, dict).SearchAllWords() {
+		found = found || result.String() == generated.Solution[0].String()
+	}
+	if !found {
+		t.Errorf("Path search does not find first move %s", generated.Solution[0])
+	}
+}
+
+// TestTowerGeneratorRandom tests random word selection and reproducibility
+func TestTowerGeneratorRandom(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "STREAM", "GARDEN", "ROCKET", "ELEPHANT")
+
+	first, err := NewTowerGenerator(6, 4, dict, 9).GenerateRandom(3, 6)
+	if err != nil {
+		t.Fatalf("GenerateRandom failed: %v", err)
+	}
+	second, err := NewTowerGenerator(6, 4, dict, 9).GenerateRandom(3, 6)
+	if err != nil {
+		t.Fatalf("GenerateRandom failed: %v", err)
+	}
+	if first.Matrix.String() != second.Matrix.String() {
+		t.Errorf("Expected the same board for the same seed:\n%s\n\n%s", first.Matrix, second.Matrix)
+	}
+	for _, move := range first.Solution {
+		if move.Word == "ELEPHANT" {
+			t.Error("Expected words longer than max length to be skipped")
+		}
+	}
+}
+
+// TestValidateTowerSolution tests rejection of a wrong solution
+func TestValidateTowerSolution(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	matrix, err := NewLetterMatrixFromString("pla\nten")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	wrong := []PathResult{{Word: "PLANET", Coordinates: []Coord{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}}}}
+	if err := ValidateTowerSolution(matrix, dict, wrong); !errors.Is(err, ErrInvalidSolution) {
+		t.Errorf("Expected ErrInvalidSolution, got %v", err)
+	}
+	if _, err := NewTowerGenerator(2, 2, dict, 1).Generate([]string{"planet"}); !errors.Is(err, ErrCannotPlace) {
+		t.Errorf("Expected ErrCannotPlace, got %v", err)
+	}
+}
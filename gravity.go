@@ -0,0 +1,284 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var ErrUnknownGravity = errors.New("gravidade desconhecida")
+
+// GravityDirection indica para onde as letras caem após uma remoção
+type GravityDirection int
+
+const (
+	GravityDown GravityDirection = iota
+	GravityUp
+	GravityLeft
+	GravityRight
+	GravityNone
+)
+
+var gravityNames = map[string]GravityDirection{
+	"down":  GravityDown,
+	"up":    GravityUp,
+	"left":  GravityLeft,
+	"right": GravityRight,
+	"none":  GravityNone,
+}
+
+func (gd GravityDirection) String() string {
+	for name, direction := range gravityNames {
+		if direction == gd {
+			return name
+		}
+	}
+	return fmt.Sprintf("GravityDirection(%d)", int(gd))
+}
+
+// Gravity define como a matriz se reorganiza depois de RemoveLetters
+type Gravity struct {
+	Direction GravityDirection
+	// Refill preenche as células liberadas com letras sorteadas em vez de espaços
+	Refill bool
+	// CollapseColumns fecha colunas vazias deslocando as demais para a esquerda
+	CollapseColumns bool
+	// Distribution é a distribuição usada no Refill (EnglishLetters se nil)
+	Distribution *LetterDistribution
+	Seed         uint64
+}
+
+// DefaultGravity é o comportamento original: letras caem para baixo e o topo fica vazio
+var DefaultGravity = Gravity{Direction: GravityDown}
+
+// ParseGravity interpreta especificações como "down", "left+refill" ou "down+refill+collapse"
+func ParseGravity(spec string, seed uint64) (Gravity, error) {
+	gravity := Gravity{Seed: seed}
+	for i, part := range strings.Split(strings.ToLower(strings.TrimSpace(spec)), "+") {
+		if i == 0 {
+			direction, ok := gravityNames[part]
+			if !ok {
+				return gravity, fmt.Errorf("%w: %q", ErrUnknownGravity, part)
+			}
+			gravity.Direction = direction
+			continue
+		}
+		switch part {
+		case "refill":
+			gravity.Refill = true
+		case "collapse":
+			gravity.CollapseColumns = true
+		default:
+			return gravity, fmt.Errorf("%w: opção %q", ErrUnknownGravity, part)
+		}
+	}
+	return gravity, nil
+}
+
+// String retorna a especificação no formato aceito por ParseGravity
+func (g Gravity) String() string {
+	spec := g.Direction.String()
+	if g.Refill {
+		spec += "+refill"
+	}
+	if g.CollapseColumns {
+		spec += "+collapse"
+	}
+	return spec
+}
+
+// LetterDistribution sorteia letras proporcionalmente aos pesos informados
+type LetterDistribution struct {
+	letters    []rune
+	cumulative []int
+	total      int
+}
+
+// englishLetterFrequencies é a frequência aproximada das letras em inglês (por 10 mil)
+var englishLetterFrequencies = map[rune]int{
+	'E': 1270, 'T': 906, 'A': 817, 'O': 751, 'I': 697, 'N': 675, 'S': 633, 'H': 609,
+	'R': 599, 'D': 425, 'L': 403, 'C': 278, 'U': 276, 'M': 241, 'W': 236, 'F': 223,
+	'G': 202, 'Y': 197, 'P': 193, 'B': 149, 'V': 98, 'K': 77, 'J': 15, 'X': 15,
+	'Q': 10, 'Z': 7,
+}
+
+// EnglishLetters é a distribuição padrão de letras do inglês
+var EnglishLetters = NewLetterDistribution(englishLetterFrequencies)
+
+// NewLetterDistribution cria uma distribuição a partir de pesos por letra
+func NewLetterDistribution(weights map[rune]int) *LetterDistribution {
+	letters := make([]rune, 0, len(weights))
+	for letter, weight := range weights {
+		if weight > 0 {
+			letters = append(letters, letter)
+		}
+	}
+	// Ordem fixa para que a mesma semente produza as mesmas letras
+	sort.Slice(letters, func(i, j int) bool { return letters[i] < letters[j] })
+
+	ld := &LetterDistribution{letters: letters, cumulative: make([]int, len(letters))}
+	for i, letter := range letters {
+		ld.total += weights[letter]
+		ld.cumulative[i] = ld.total
+	}
+	return ld
+}
+
+// Pick sorteia uma letra usando o gerador informado
+func (ld *LetterDistribution) Pick(rng *rand.Rand) rune {
+	n := rng.IntN(ld.total)
+	i := sort.SearchInts(ld.cumulative, n+1)
+	return ld.letters[i]
+}
+
+// SetGravity define a política de gravidade e reinicia o sorteio de reposição pela semente
+func (lm *LetterMatrix) SetGravity(gravity Gravity) {
+	if gravity.Refill && gravity.Distribution == nil {
+		gravity.Distribution = EnglishLetters
+	}
+	lm.gravity = gravity
+	lm.refillSource = rand.NewPCG(gravity.Seed, gravity.Seed)
+}
+
+// GetGravity retorna a política de gravidade da matriz
+func (lm *LetterMatrix) GetGravity() Gravity {
+	return lm.gravity
+}
+
+// applyGravity compacta as linhas ou colunas na direção da gravidade, ignorando as células removidas
+func (lm *LetterMatrix) applyGravity(removed [][]bool) {
+	special := make([][]bool, lm.rows)
+	for i := range special {
+		special[i] = make([]bool, lm.cols)
+		for j := range special[i] {
+			special[i][j] = lm.IsSpecial(Coord{X: i, Y: j})
+		}
+	}
+
+	var rng *rand.Rand
+	if lm.gravity.Refill {
+		if lm.refillSource == nil {
+			lm.refillSource = rand.NewPCG(lm.gravity.Seed, lm.gravity.Seed)
+		}
+		rng = rand.New(lm.refillSource)
+	}
+	refill := func() rune {
+		if rng == nil {
+			return ' '
+		}
+		return unicode.ToLower(lm.gravity.Distribution.Pick(rng))
+	}
+
+	// lines lista, para cada linha de queda, as coordenadas do "chão" até o topo
+	var lines [][]Coord
+	switch lm.gravity.Direction {
+	case GravityDown, GravityUp:
+		for j := 0; j < lm.cols; j++ {
+			line := make([]Coord, lm.rows)
+			for i := range line {
+				if lm.gravity.Direction == GravityDown {
+					line[i] = Coord{X: lm.rows - 1 - i, Y: j}
+				} else {
+					line[i] = Coord{X: i, Y: j}
+				}
+			}
+			lines = append(lines, line)
+		}
+	case GravityLeft, GravityRight:
+		for i := 0; i < lm.rows; i++ {
+			line := make([]Coord, lm.cols)
+			for j := range line {
+				if lm.gravity.Direction == GravityRight {
+					line[j] = Coord{X: i, Y: lm.cols - 1 - j}
+				} else {
+					line[j] = Coord{X: i, Y: j}
+				}
+			}
+			lines = append(lines, line)
+		}
+	case GravityNone:
+		for i := 0; i < lm.rows; i++ {
+			for j := 0; j < lm.cols; j++ {
+				if removed[i][j] && lm.matrix[i][j] != MASK_CELL {
+					lm.matrix[i][j] = refill()
+					special[i][j] = false
+				}
+			}
+		}
+	}
+
+	for _, line := range lines {
+		// Células bloqueadas ficam fixas: as letras caem através delas até a próxima célula da matriz
+		line = slices.DeleteFunc(line, func(coord Coord) bool { return lm.matrix[coord.X][coord.Y] == MASK_CELL })
+		// Buracos (espaços) não seguram letras: as letras caem através deles e os buracos sobem.
+		// A reposição só cobre as células removidas, então o número de buracos se mantém.
+		target, refills := 0, 0
+		for _, coord := range line {
+			if removed[coord.X][coord.Y] {
+				refills++
+				continue
+			}
+			if !isLetter(lm.matrix[coord.X][coord.Y]) {
+				continue
+			}
+			to := line[target]
+			lm.matrix[to.X][to.Y] = lm.matrix[coord.X][coord.Y]
+			special[to.X][to.Y] = special[coord.X][coord.Y]
+			target++
+		}
+		for ; target < len(line); target++ {
+			to := line[target]
+			lm.matrix[to.X][to.Y] = ' '
+			if refills > 0 {
+				lm.matrix[to.X][to.Y] = refill()
+				refills--
+			}
+			special[to.X][to.Y] = false
+		}
+	}
+
+	// Recolher colunas deslocaria a máscara, por isso não se aplica a matrizes com células bloqueadas
+	if lm.gravity.CollapseColumns && lm.CountMasked() == 0 {
+		lm.collapseColumns(special)
+	}
+
+	lm.specials = lm.specials[:0]
+	for i := range special {
+		for j := range special[i] {
+			if special[i][j] {
+				lm.specials = append(lm.specials, fmt.Sprintf("(%d,%d)", i+1, j+1))
+			}
+		}
+	}
+}
+
+// collapseColumns remove colunas vazias deslocando as demais para a esquerda
+func (lm *LetterMatrix) collapseColumns(special [][]bool) {
+	target := 0
+	for j := 0; j < lm.cols; j++ {
+		empty := true
+		for i := 0; i < lm.rows && empty; i++ {
+			empty = lm.matrix[i][j] == ' '
+		}
+		if empty {
+			continue
+		}
+		if target != j {
+			for i := 0; i < lm.rows; i++ {
+				lm.matrix[i][target] = lm.matrix[i][j]
+				special[i][target] = special[i][j]
+			}
+		}
+		target++
+	}
+	for ; target < lm.cols; target++ {
+		for i := 0; i < lm.rows; i++ {
+			lm.matrix[i][target] = ' '
+			special[i][target] = false
+		}
+	}
+}
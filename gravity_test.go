@@ -0,0 +1,199 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func matrixRows(lm *LetterMatrix) string {
+	rows := make([]string, 0, len(lm.GetMatrix()))
+	for _, row := range lm.GetMatrix() {
+		rows = append(rows, string(row))
+	}
+	return strings.Join(rows, "/")
+}
+
+// TestGravityDirections tests letters falling in each direction after removing 'e'
+func TestGravityDirections(t *testing.T) {
+	testCases := []struct {
+		spec     string
+		expected string
+		specials string
+	}{
+		{"down", "a c/dBf/ghi", "(2,2)"},
+		{"up", "aBc/dhf/g i", "(1,2)"},
+		{"left", "aBc/df /ghi", "(1,2)"},
+		{"right", "aBc/ df/ghi", "(1,2)"},
+		{"none", "aBc/d f/ghi", "(1,2)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			matrix, err := NewLetterMatrixFromString("aBc\ndef\nghi")
+			if err != nil {
+				t.Fatalf("Failed to create matrix: %v", err)
+			}
+			gravity, err := ParseGravity(tc.spec, 0)
+			if err != nil {
+				t.Fatalf("ParseGravity failed: %v", err)
+			}
+			matrix.SetGravity(gravity)
+			matrix.RemoveLetters([]Coord{{X: 1, Y: 1}})
+
+			if got := matrixRows(matrix); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+			if got := strings.Join(matrix.specials, ""); got != tc.specials {
+				t.Errorf("Expected specials %q, got %q", tc.specials, got)
+			}
+		})
+	}
+}
+
+// TestGravityCollapseColumns tests that emptied columns close up to the left
+func TestGravityCollapseColumns(t *testing.T) {
+	matrix, err := NewLetterMatrixFromString("abc\ndef")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	gravity, err := ParseGravity("down+collapse", 0)
+	if err != nil {
+		t.Fatalf("ParseGravity failed: %v", err)
+	}
+	matrix.SetGravity(gravity)
+	matrix.RemoveLetters([]Coord{{X: 0, Y: 1}, {X: 1, Y: 1}})
+
+	if got := matrixRows(matrix); got != "ac /df " {
+		t.Errorf("Expected \"ac /df \", got %q", got)
+	}
+}
+
+// TestGravityMask tests that masked cells stay fixed while letters fall through them
+func TestGravityMask(t *testing.T) {
+	testCases := []struct {
+		spec     string
+		board    string
+		removed  []Coord
+		expected string
+	}{
+		{"down", "abc\nd#f\nghi", []Coord{{X: 2, Y: 1}}, "a c/d#f/gbi"},
+		{"left", "ab#c\nefgh", []Coord{{X: 0, Y: 0}}, "bc# /efgh"},
+		{"none", "a#c\ndef", []Coord{{X: 0, Y: 1}}, "a#c/def"},
+		{"down+collapse", "a#\nbc", []Coord{{X: 0, Y: 0}, {X: 1, Y: 0}}, " #/ c"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			matrix, err := NewLetterMatrixFromString(tc.board)
+			if err != nil {
+				t.Fatalf("Failed to create matrix: %v", err)
+			}
+			gravity, err := ParseGravity(tc.spec, 0)
+			if err != nil {
+				t.Fatalf("ParseGravity failed: %v", err)
+			}
+			matrix.SetGravity(gravity)
+			matrix.RemoveLetters(tc.removed)
+
+			if got := matrixRows(matrix); got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestGravityInteriorHole tests that letters fall through empty cells instead of resting on them
+func TestGravityInteriorHole(t *testing.T) {
+	testCases := []struct {
+		spec     string
+		expected string
+	}{
+		{"down", "ab /ab /abc"},
+		{"down+refill", "ab /abx/abc"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			matrix, err := NewLetterMatrixFromString("abc\nab\nabc")
+			if err != nil {
+				t.Fatalf("Failed to create matrix: %v", err)
+			}
+			gravity, err := ParseGravity(tc.spec, 0)
+			if err != nil {
+				t.Fatalf("ParseGravity failed: %v", err)
+			}
+			matrix.SetGravity(gravity)
+			matrix.RemoveLetters([]Coord{{X: 2, Y: 2}})
+
+			got := matrixRows(matrix)
+			if tc.spec == "down+refill" {
+				// The refilled letter is random: only its position and the hole's matter
+				if len(got) != len(tc.expected) || got[:6] != tc.expected[:6] || got[7:] != tc.expected[7:] || !isLetter(rune(got[6])) {
+					t.Errorf("Expected %q with a refilled letter at (2,3), got %q", tc.expected, got)
+				}
+				return
+			}
+			if got != tc.expected {
+				t.Errorf("Expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestGravityRefillSeeded tests that random refill is reproducible by seed
+func TestGravityRefillSeeded(t *testing.T) {
+	run := func(seed uint64) string {
+		matrix, err := NewLetterMatrixFromString("abc\ndef\nghi")
+		if err != nil {
+			t.Fatalf("Failed to create matrix: %v", err)
+		}
+		gravity, err := ParseGravity("down+refill", seed)
+		if err != nil {
+			t.Fatalf("ParseGravity failed: %v", err)
+		}
+		matrix.SetGravity(gravity)
+		matrix.RemoveLetters([]Coord{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}})
+		matrix.RemoveLetters([]Coord{{X: 2, Y: 0}, {X: 2, Y: 1}})
+		return matrixRows(matrix)
+	}
+
+	first := run(42)
+	if first != run(42) {
+		t.Errorf("Expected same board for same seed, got %q and %q", first, run(42))
+	}
+	if strings.Contains(first, " ") {
+		t.Errorf("Expected refilled board without holes, got %q", first)
+	}
+	if first[len(first)-1] != 'f' {
+		t.Errorf("Expected 'f' to fall into the bottom right cell, got %q", first)
+	}
+}
+
+// TestParseGravity tests gravity spec parsing
+func TestParseGravity(t *testing.T) {
+	gravity, err := ParseGravity("Left+refill+collapse", 7)
+	if err != nil {
+		t.Fatalf("ParseGravity failed: %v", err)
+	}
+	if gravity.String() != "left+refill+collapse" || gravity.Seed != 7 {
+		t.Errorf("Unexpected gravity %+v", gravity)
+	}
+	if _, err := ParseGravity("sideways", 0); !errors.Is(err, ErrUnknownGravity) {
+		t.Errorf("Expected ErrUnknownGravity, got %v", err)
+	}
+	if _, err := ParseGravity("down+bounce", 0); !errors.Is(err, ErrUnknownGravity) {
+		t.Errorf("Expected ErrUnknownGravity, got %v", err)
+	}
+}
+
+// TestLetterDistribution tests that only weighted letters are picked
+func TestLetterDistribution(t *testing.T) {
+	matrix, _ := NewLetterMatrixFromString("ab")
+	matrix.SetGravity(Gravity{Direction: GravityNone, Refill: true,
+		Distribution: NewLetterDistribution(map[rune]int{'Q': 1, 'Z': 0})})
+	matrix.RemoveLetters([]Coord{{X: 0, Y: 0}, {X: 0, Y: 1}})
+	if got := matrixRows(matrix); got != "qq" {
+		t.Errorf("Expected \"qq\", got %q", got)
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+//go:generate protoc --go_out=. --go_opt=module=wordgo --go-grpc_out=. --go-grpc_opt=module=wordgo -I proto proto/wordgo.proto
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"wordgo/wordgopb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCSolver implementa o serviço wordgo.v1.Solver com o mesmo motor da linha de comando
+type GRPCSolver struct {
+	wordgopb.UnimplementedSolverServer
+	dictionary *Dictionary
+	// timeout vale para chamadas sem prazo definido pelo cliente
+	timeout time.Duration
+}
+
+// NewGRPCSolver cria o serviço com o dicionário compartilhado
+func NewGRPCSolver(dictionary *Dictionary, timeout time.Duration) *GRPCSolver {
+	return &GRPCSolver{dictionary: dictionary, timeout: timeout}
+}
+
+// NewGRPCServer cria um servidor gRPC com o serviço registrado
+func NewGRPCServer(dictionary *Dictionary, timeout time.Duration) *grpc.Server {
+	server := grpc.NewServer()
+	wordgopb.RegisterSolverServer(server, NewGRPCSolver(dictionary, timeout))
+	return server
+}
+
+func (gs *GRPCSolver) Solve(ctx context.Context, request *wordgopb.SolveRequest) (*wordgopb.SolveResponse, error) {
+	matrix, scorer, err := gs.prepare(request)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := gs.withTimeout(ctx)
+	defer cancel()
+
+	found, err := searchBoardContext(ctx, matrix, gs.dictionary, scorer)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	sortPathResults(found)
+
+	rows, cols := matrix.GetDimensions()
+	response := &wordgopb.SolveResponse{Rows: int32(rows), Cols: int32(cols), Rules: scorer.Name(), Total: int32(len(found))}
+	if limit := int(request.GetLimit()); limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+	response.Words = make([]*wordgopb.FoundWord, len(found))
+	for i, result := range found {
+		response.Words[i] = foundWordToProto(result)
+	}
+	return response, nil
+}
+
+// StreamSolve envia cada palavra assim que ela é encontrada
+func (gs *GRPCSolver) StreamSolve(request *wordgopb.SolveRequest, stream grpc.ServerStreamingServer[wordgopb.FoundWord]) error {
+	matrix, scorer, err := gs.prepare(request)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := gs.withTimeout(stream.Context())
+	defer cancel()
+
+	for result := range Solve(ctx, matrix, gs.dictionary, scorer) {
+		if err := stream.Send(foundWordToProto(result)); err != nil {
+			return err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
+
+// Plan analisa uma matriz do modo torre e procura a sequência que a esvazia
+func (gs *GRPCSolver) Plan(ctx context.Context, request *wordgopb.PlanRequest) (*wordgopb.PlanResponse, error) {
+	matrix, err := boardFromProto(request.GetBoard(), "")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, cancel := gs.withTimeout(ctx)
+	defer cancel()
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	// A matriz vem do cliente: o contexto e o limite de estados impedem uma busca sem fim
+	result, err := CanClear(ctx, matrix, gs.dictionary)
+	if errors.Is(err, ErrBoardTooLarge) || errors.Is(err, ErrRefillUnsupported) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, ErrClearBudget) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return nil, status.FromContextError(err).Err()
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &wordgopb.PlanResponse{
+		CanClear:     result.CanClear,
+		MinRemaining: int32(result.MinRemaining),
+		States:       int32(result.States),
+		Moves:        make([]*wordgopb.FoundWord, len(result.Moves)),
+	}
+	for i, move := range result.Moves {
+		response.Moves[i] = foundWordToProto(move)
+	}
+	response.Stranded = cellsToProto(AnalyzeTower(matrix, gs.dictionary).Stranded)
+	return response, nil
+}
+
+// prepare monta a matriz e as regras da requisição, respondendo InvalidArgument em caso de erro
+func (gs *GRPCSolver) prepare(request *wordgopb.SolveRequest) (*LetterMatrix, Scorer, error) {
+	matrix, err := boardFromProto(request.GetBoard(), request.GetGame())
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rules := request.GetRules()
+	if rules == "" {
+		rules = matrix.GetMeta().Rules
+	}
+	if rules == "" {
+		rules = DEFAULT_RULES
+	}
+	scorer, err := NewScorer(rules)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return matrix, scorer, nil
+}
+
+func (gs *GRPCSolver) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || gs.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, gs.timeout)
+}
+
+// boardFromProto reaproveita a montagem de matriz da API HTTP
+func boardFromProto(board *wordgopb.Board, game string) (*LetterMatrix, error) {
+	return SolveRequest{Board: board.GetText(), Grid: board.GetGrid(), Game: game}.matrix()
+}
+
+func foundWordToProto(result PathResult) *wordgopb.FoundWord {
+	word := &wordgopb.FoundWord{
+		Word:  result.Word,
+		Path:  cellsToProto(result.Coordinates),
+		Score: int32(result.Score.Total),
+	}
+	for _, item := range result.Score.Breakdown {
+		word.Breakdown = append(word.Breakdown, &wordgopb.ScoreItem{Rule: item.Rule, Points: int32(item.Points)})
+	}
+	return word
+}
+
+func cellsToProto(coords []Coord) []*wordgopb.Cell {
+	cells := make([]*wordgopb.Cell, len(coords))
+	for i, coord := range coords {
+		cells[i] = &wordgopb.Cell{Row: int32(coord.X + 1), Col: int32(coord.Y + 1)}
+	}
+	return cells
+}
+
+// serveGRPC escuta no endereço informado até o servidor parar
+func serveGRPC(addr string, server *grpc.Server) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return server.Serve(listener)
+}
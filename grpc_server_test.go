@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"wordgo/wordgopb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestSolverClient starts the gRPC service in-process over bufconn
+func newTestSolverClient(t *testing.T, words ...string) wordgopb.SolverClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := NewGRPCServer(loadTestDictionary(t, words...), time.Second)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return wordgopb.NewSolverClient(conn)
+}
+
+// TestGRPCSolve tests the unary Solve call
+func TestGRPCSolve(t *testing.T) {
+	client := newTestSolverClient(t, "PLANET", "PLANETS")
+
+	response, err := client.Solve(context.Background(), &wordgopb.SolveRequest{
+		Board: &wordgopb.Board{Grid: []string{"plan", "stex"}},
+		Rules: "boggle",
+	})
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if response.GetRows() != 2 || response.GetCols() != 4 || response.GetRules() != "boggle" || response.GetTotal() != 2 {
+		t.Errorf("Unexpected response: %v", response)
+	}
+	best := response.GetWords()[0]
+	if best.GetWord() != "PLANETS" || len(best.GetPath()) != 7 || best.GetPath()[0].GetRow() != 1 || best.GetPath()[0].GetCol() != 1 {
+		t.Errorf("Unexpected best word: %v", best)
+	}
+
+	_, err = client.Solve(context.Background(), &wordgopb.SolveRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a missing board, got %v", err)
+	}
+}
+
+// TestGRPCStreamSolve tests that StreamSolve sends every word as it is found
+func TestGRPCStreamSolve(t *testing.T) {
+	client := newTestSolverClient(t, "PLANET", "PLANETS")
+
+	stream, err := client.StreamSolve(context.Background(), &wordgopb.SolveRequest{
+		Board: &wordgopb.Board{Text: "plan\nstex"},
+	})
+	if err != nil {
+		t.Fatalf("StreamSolve failed: %v", err)
+	}
+	var words []string
+	for {
+		word, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		words = append(words, word.GetWord())
+	}
+	// Words arrive in discovery order, so the prefix comes first
+	if len(words) != 2 || words[0] != "PLANET" || words[1] != "PLANETS" {
+		t.Errorf("Expected PLANET then PLANETS, got %v", words)
+	}
+}
+
+// TestGRPCPlan tests the tower planner
+func TestGRPCPlan(t *testing.T) {
+	client := newTestSolverClient(t, "PLANET", "STREAM")
+
+	response, err := client.Plan(context.Background(), &wordgopb.PlanRequest{
+		Board: &wordgopb.Board{Grid: []string{"str", "pla", "ten", "mae"}},
+	})
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if !response.GetCanClear() || len(response.GetMoves()) != 2 || response.GetMoves()[0].GetWord() != "PLANET" {
+		t.Errorf("Expected PLANET then STREAM, got %v", response)
+	}
+
+	large := make([]string, 7)
+	for i := range large {
+		large[i] = "abcdef"
+	}
+	_, err = client.Plan(context.Background(), &wordgopb.PlanRequest{Board: &wordgopb.Board{Grid: large}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition for a large board, got %v", err)
+	}
+
+	refill := &wordgopb.Board{Text: "---\ngravity: down+refill\n---\nstr\npla\nten\nmae"}
+	_, err = client.Plan(context.Background(), &wordgopb.PlanRequest{Board: refill})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition for refill gravity, got %v", err)
+	}
+}
@@ -1,14 +1,13 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
-	"sort"
 	"strings"
-	"sync"
 	"time"
 )
 
@@ -24,52 +23,254 @@ const (
 	MAX_GOROUTINES         = 32
 	MIN_WORD_LENGTH        = 6
 	MODE_SQUARE_SEARCH     = true
+	DEFAULT_DICTIONARY     = "res/words.txt"
 )
 
-//var chFoundWords chan []rune
-
-//var chRoutineFinalize chan bool
-
-var foundWords map[string]bool
-var foundWordsMutex sync.Mutex
-
 func main() {
+	// Subcomandos
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "play":
+			runPlay(os.Args[2:])
+			return
+		case "tui":
+			runTUI(os.Args[2:])
+			return
+		case "solve":
+			runSolve(os.Args[2:])
+			return
+		case "generate":
+			runGenerate(os.Args[2:])
+			return
+		case "batch":
+			runBatch(os.Args[2:])
+			return
+		case "serve":
+			runServe(os.Args[2:])
+			return
+		case "find":
+			runFind(os.Args[2:])
+			return
+		}
+	}
+
 	fmt.Println("=== WordGo - Buscador de Palavras em Matriz de Letras ===")
 
 	// Definir flag para arquivo de matriz
-	matrixFile := flag.String("matrix", "res/example.txt", "Arquivo de matriz de letras para carregar")
+	matrixFile := flag.String("matrix", "res/example.txt", "Arquivo de matriz de letras para carregar (\"-\" lê da entrada padrão)")
+	grid := flag.String("grid", "", "Matriz em linha com as linhas separadas por '/', ex: \"abc/def/ghi\"")
+	batch := flag.Bool("batch", false, "O arquivo contém várias matrizes separadas por linhas em branco")
+	rules := flag.String("rules", DEFAULT_RULES, "Regras de pontuação: "+strings.Join(RuleSetNames(), ", "))
+	breakdown := flag.Bool("breakdown", false, "Exibe o detalhamento da pontuação de cada palavra")
+	analyze := flag.Bool("analyze", false, "Analisa a matriz do modo torre (células presas e se pode ser esvaziada)")
+	dictFile := flag.String("dict", DEFAULT_DICTIONARY, "Arquivo de dicionário")
+	stream := flag.Bool("stream", false, "Imprime cada palavra assim que é encontrada")
+	aggregateName := flag.String("aggregate", DEFAULT_AGGREGATE, "Agrupamento dos caminhos por palavra: all, unique, best ou specials")
+	adjacencyName := flag.String("adjacency", "", "Vizinhança das células: "+strings.Join(AdjacencyNames(), ", ")+" (padrão: a do cabeçalho ou king)")
+	bendsSpec := flag.String("bends", "", "Curvas na busca em linha reta (caça-palavras): N ou N@ângulos, ex: \"1@90\" ou \"2@45,90\"")
+	top := flag.Int("top", 0, "Mostra só as N melhores palavras, podando os caminhos que não podem alcançá-las (0 desativa)")
+	topByName := flag.String("top-by", DEFAULT_TOP_BY, "Critério do -top: length ou score")
+	wrap := flag.Bool("wrap", false, "Liga as bordas opostas da matriz: palavras continuam do outro lado sem repetir células")
 	flag.Parse()
 
-	// Validar se o arquivo especificado existe
-	if _, err := os.Stat(*matrixFile); os.IsNotExist(err) {
-		log.Fatalf("Arquivo de matriz não encontrado: %s", *matrixFile)
+	aggregate, err := ParseAggregateMode(*aggregateName)
+	if err != nil {
+		log.Fatal(err)
 	}
+	topBy, err := ParseTopKCriterion(*topByName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	bends, err := ParseBends(*bendsSpec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var adjacency Adjacency
+	if *adjacencyName != "" {
+		if adjacency, err = ParseAdjacency(*adjacencyName); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	explicit := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
 
-	// Carregar matriz de letras
-	fmt.Printf("Carregando matriz de letras de: %s\n", *matrixFile)
-	matrix, err := NewLetterMatrixFromFile(*matrixFile)
+	// Carregar matrizes de letras
+	boards, err := loadBoards(*matrixFile, *grid, *batch)
 	if err != nil {
 		log.Fatalf("Erro ao carregar matriz: %v", err)
 	}
-	matrix.PrintMatrix()
-	fmt.Println()
 
-	// Carregar dicionário
-	fmt.Println("Carregando dicionário...")
-	dict, err := NewDictionary("res/words.txt")
+	dictionaries := make(map[string]*Dictionary)
+	for i, matrix := range boards {
+		if adjacency != nil {
+			if err := matrix.SetAdjacency(adjacency); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if *bendsSpec != "" {
+			matrix.SetBends(bends)
+		}
+		if *wrap {
+			if err := matrix.SetAdjacency(WithWrap(matrix.GetAdjacency())); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if len(boards) > 1 {
+			fmt.Printf("\n=== Matriz %d de %d ===\n", i+1, len(boards))
+		}
+		matrix.PrintMatrix()
+		fmt.Println()
+
+		// O cabeçalho da matriz define os padrões; flags explícitas têm precedência
+		meta := matrix.GetMeta()
+		boardDict, boardRules := *dictFile, *rules
+		if meta.Dictionary != "" && !explicit["dict"] {
+			boardDict = meta.Dictionary
+		}
+		if meta.Rules != "" && !explicit["rules"] {
+			boardRules = meta.Rules
+		}
+
+		// Carregar dicionário (uma vez por arquivo)
+		dict, ok := dictionaries[boardDict]
+		if !ok {
+			fmt.Println("Carregando dicionário...")
+			if dict, err = NewDictionary(boardDict); err != nil {
+				log.Fatalf("Erro ao carregar dicionário: %v", err)
+			}
+			dict.PrintDictionaryStats()
+			fmt.Println()
+			dictionaries[boardDict] = dict
+		}
+
+		if *analyze {
+			analyzeTower(matrix, dict)
+			continue
+		}
+
+		scorer, err := NewScorer(boardRules)
+		if err != nil {
+			log.Fatalf("Erro ao selecionar regras: %v", err)
+		}
+		if *top > 0 {
+			topBoard(matrix, dict, scorer, *top, topBy, *breakdown)
+			continue
+		}
+		if *stream {
+			if err := streamBoard(matrix, dict, scorer, aggregate, *breakdown); err != nil {
+				log.Fatalf("Erro na busca: %v", err)
+			}
+			continue
+		}
+		solveBoard(matrix, dict, scorer, aggregate, *breakdown, len(boards) == 1)
+	}
+}
+
+// loadBoards carrega a matriz em linha (-grid), da entrada padrão ("-") ou do arquivo;
+// com batch, o arquivo pode conter várias matrizes
+func loadBoards(matrixFile, grid string, batch bool) ([]*LetterMatrix, error) {
+	if grid != "" {
+		fmt.Println("Carregando matriz de letras da linha de comando")
+		matrix, err := NewLetterMatrixFromGrid(grid)
+		if err != nil {
+			return nil, err
+		}
+		return []*LetterMatrix{matrix}, nil
+	}
+
+	input := os.Stdin
+	if matrixFile == "-" {
+		fmt.Println("Carregando matriz de letras da entrada padrão")
+	} else {
+		// Validar se o arquivo especificado existe
+		if _, err := os.Stat(matrixFile); os.IsNotExist(err) {
+			return nil, fmt.Errorf("arquivo de matriz não encontrado: %w", err)
+		}
+		fmt.Printf("Carregando matriz de letras de: %s\n", matrixFile)
+		file, err := os.Open(matrixFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s da matriz: %w", ErrFileOpen, err)
+		}
+		defer file.Close()
+		input = file
+	}
+
+	if batch {
+		return ReadBoards(input)
+	}
+	matrix, err := NewLetterMatrixFromReader(input)
 	if err != nil {
-		log.Fatalf("Erro ao carregar dicionário: %v", err)
+		return nil, err
 	}
-	dict.PrintDictionaryStats()
-	fmt.Println()
+	return []*LetterMatrix{matrix}, nil
+}
 
-	directions := NewDirections()
+// streamBoard imprime cada palavra assim que é encontrada e, ao final, a lista ordenada
+func streamBoard(matrix *LetterMatrix, dict *Dictionary, scorer Scorer, aggregate AggregateMode, breakdown bool) error {
+	fmt.Println("\n=== Iniciando Busca de Palavras ===")
+	fmt.Printf("Regras de pontuação: %s\n", scorer.Name())
+
+	results := Solve(context.Background(), matrix, dict, scorer)
+	if os.Getenv("CFG_SIMPLE") == "true" && matrix.GetMeta().Game != GameWordSearch {
+		simple := matrix.Clone()
+		meta := simple.GetMeta()
+		meta.Game = GameWordSearch
+		if err := simple.SetMeta(meta); err != nil {
+			return err
+		}
+		results = Solve(context.Background(), simple, dict, scorer)
+	}
 
+	found := make([]PathResult, 0, 128)
+	for result := range results {
+		fmt.Printf("%s [%d]\n", result, result.Score.Total)
+		found = append(found, result)
+	}
+	fmt.Println("\nAll found words:")
+	printAggregated(found, aggregate, matrix, breakdown)
+	return nil
+}
+
+// topBoard imprime as k melhores palavras; no caça-palavras, seleciona entre todos os resultados
+func topBoard(matrix *LetterMatrix, dict *Dictionary, scorer Scorer, k int, by TopKCriterion, breakdown bool) {
+	fmt.Println("\n=== Melhores Palavras ===")
+	fmt.Printf("Regras de pontuação: %s\n", scorer.Name())
+
+	start := time.Now()
+	var top []PathResult
+	if matrix.GetMeta().Game == GameWordSearch {
+		top = TopKResults(searchBoard(matrix, dict, scorer), k, by)
+	} else {
+		searcher := NewPathSearcher(matrix, dict)
+		searcher.SetScorer(scorer)
+		top, _ = searcher.TopK(context.Background(), k, by)
+	}
+	for i, result := range top {
+		if breakdown {
+			fmt.Printf("%2d. %s = %s\n", i+1, result, result.Score)
+			continue
+		}
+		fmt.Printf("%2d. %s [%d]\n", i+1, result, result.Score.Total)
+	}
+	fmt.Printf("\nBusca concluída em %s\n", time.Since(start))
+}
+
+// solveBoard busca e imprime as palavras de uma matriz; pause mantém as pausas da exibição interativa
+func solveBoard(matrix *LetterMatrix, dict *Dictionary, scorer Scorer, aggregate AggregateMode, breakdown, pause bool) {
 	// Iniciar busca de palavras
 	fmt.Println("\n=== Iniciando Busca de Palavras ===")
+	fmt.Printf("Regras de pontuação: %s\n", scorer.Name())
+
+	wait := func(d time.Duration) {
+		if pause {
+			time.Sleep(d)
+		}
+	}
 
-	if os.Getenv("CFG_SIMPLE") == "true" {
+	if os.Getenv("CFG_SIMPLE") == "true" || matrix.GetMeta().Game == GameWordSearch {
 		simpleSearcher := NewWordSimpleSearcher(matrix, dict)
+		simpleSearcher.SetScorer(scorer)
 
 		// Usar 4 workers para processamento paralelo
 		numWorkers := 4
@@ -78,198 +279,122 @@ func main() {
 
 		// Exibir resultados
 		simpleSearcher.PrintResults()
-		time.Sleep(5000 * time.Millisecond)
+		wait(5000 * time.Millisecond)
 		return
 	}
 
 	dimX, dimY := matrix.GetDimensions()
+	searcher := NewPathSearcher(matrix, dict)
+	searcher.SetScorer(scorer)
 
-	allFoundWordsList := make([]string, 0, 128)
+	allFoundWordsList := make([]PathResult, 0, 128)
 
 	for startX := range dimX {
 		for startY := range dimY {
-			allFoundWordsList = searchStartingPoint(startX, startY, matrix, dict, directions, allFoundWordsList)
+			allFoundWordsList = searchStartingPoint(startX, startY, searcher, allFoundWordsList)
+			wait(100 * time.Millisecond)
 		}
 	}
 
 	fmt.Println("All found words:")
-	sortAndPrint(allFoundWordsList, matrix.specials)
-	time.Sleep(5000 * time.Millisecond)
+	printAggregated(allFoundWordsList, aggregate, matrix, breakdown)
+	wait(5000 * time.Millisecond)
 	if len(matrix.specials) > 0 {
-		filteredWordsList := make([]string, 0)
-		for _, word := range allFoundWordsList {
-			for _, special := range matrix.specials {
-				if strings.Contains(word, special) {
-					filteredWordsList = append(filteredWordsList, word)
-					break
-				}
+		filteredWordsList := make([]PathResult, 0)
+		for _, result := range allFoundWordsList {
+			if matrix.CountSpecials(result.Coordinates) > 0 {
+				filteredWordsList = append(filteredWordsList, result)
 			}
 		}
 		fmt.Println("\n\n\nAll found words in specials:")
 		if len(filteredWordsList) == 0 {
 			fmt.Printf("no words found... BOOO HOOO")
-			time.Sleep(5000 * time.Millisecond)
+			wait(5000 * time.Millisecond)
 		} else {
-			sortAndPrint(filteredWordsList, matrix.specials)
-			time.Sleep(5000 * time.Millisecond)
+			printAggregated(filteredWordsList, aggregate, matrix, breakdown)
+			wait(5000 * time.Millisecond)
 		}
 	}
 }
 
-func searchStartingPoint(startX int, startY int, matrix *LetterMatrix, dict *Dictionary, directions *[]string, allFoundWordsList []string) []string {
-	fmt.Printf("(%d,%d) -> ", startX+1, startY+1)
-	if matrix.GetMatrix()[startX][startY] == ' ' {
-		return allFoundWordsList
-	}
-
-	start := &Word{}
+func analyzeTower(matrix *LetterMatrix, dict *Dictionary) {
+	fmt.Println("=== Análise da Torre ===")
+	AnalyzeTower(matrix, dict).PrintTowerAnalysis(matrix)
 
-	start.word = make([]rune, 0)
-	start.coordinates = make([]Coord, 0)
-	start.matrix = matrix
-	start.dictionary = dict
-	start.directions = directions
-	coord := &Coord{
-		X: startX,
-		Y: startY,
+	result, err := CanClear(context.Background(), matrix, dict)
+	if err != nil {
+		fmt.Printf("Busca exaustiva ignorada: %v\n", err)
+		return
 	}
-	start.word = append(start.word, matrix.GetMatrix()[startX][startY])
-	start.coordinates = append(start.coordinates, *coord)
-
-	// Initialize foundWords map
-	foundWords = make(map[string]bool)
+	fmt.Printf("\nPode ser esvaziada: %t (sobram no mínimo %d letras, %d estados avaliados)\n",
+		result.CanClear, result.MinRemaining, result.States)
+	for i, move := range result.Moves {
+		fmt.Printf("%2d. %s\n", i+1, move)
+	}
+}
 
-	var wg sync.WaitGroup
-	limitGoroutines := make(chan struct{}, MAX_GOROUTINES)
-	wg.Go(func() {
-		toWalk(*start, limitGoroutines)
-	})
-	//fmt.Println("Waiting for words to be found...")
-	wg.Wait()
+func searchStartingPoint(startX int, startY int, searcher *PathSearcher, allFoundWordsList []PathResult) []PathResult {
+	fmt.Printf("(%d,%d) -> ", startX+1, startY+1)
+	foundWordsList := searcher.SearchFromPosition(startX, startY)
 
-	if len(foundWords) > 0 {
+	if len(foundWordsList) > 0 {
 		fmt.Printf("found words: ")
-
-		// Print all found words
-		foundWordsList := make([]string, 0, len(foundWords))
-		for word := range foundWords {
-			foundWordsList = append(foundWordsList, word)
-		}
 		allFoundWordsList = append(allFoundWordsList, foundWordsList...)
-
-		sortAndPrint(foundWordsList, []string{})
-		// } else {
-		// 	fmt.Println("No words found...")
+		sortAndPrint(foundWordsList, false)
 	} else {
 		fmt.Println()
 	}
-	time.Sleep(100 * time.Millisecond)
 	return allFoundWordsList
 }
 
-func addFoundWord(word string) {
-	foundWordsMutex.Lock()
-	defer foundWordsMutex.Unlock()
-	foundWords[word] = true
-}
+// sortAndPrint ordena os resultados pela pontuação e imprime em colunas.
+// Com breakdown, imprime um resultado por linha com o detalhamento da pontuação.
+func sortAndPrint(results []PathResult, breakdown bool) {
+	if len(results) == 0 {
+		fmt.Println("No words found...")
+		return
+	}
 
-func toWalk(word Word, limitGoroutines chan struct{}) {
-	//word.PrintBreadCrumb()
-	var wg sync.WaitGroup
-	for _, dir := range *word.directions {
-		//Clone the word
-		newWord := Word{
-			word:        make([]rune, len(word.word)),
-			coordinates: make([]Coord, len(word.coordinates)),
-			matrix:      word.matrix,
-			dictionary:  word.dictionary,
-			directions:  word.directions,
-		}
-		copy(newWord.word, word.word)
-		copy(newWord.coordinates, word.coordinates)
-		if newWord.canWalk(dir) {
-			//time.Sleep(10 * time.Millisecond)
-			//fmt.Printf(">")
-			limitGoroutines <- struct{}{}
-			wg.Go(func() {
-				toWalk(newWord, limitGoroutines)
-				//fmt.Printf("\b")
-				<-limitGoroutines
-				//time.Sleep(10 * time.Millisecond)
-			})
-			wg.Wait()
+	sortPathResults(results)
+	if breakdown {
+		for _, result := range results {
+			fmt.Printf("%s = %s\n", result, result.Score)
 		}
+		return
 	}
-}
-
-// T B L R
-func (w *Word) canWalk(toPosition string) bool {
-	rows, cols := w.matrix.GetDimensions()
-	newCoord, err := w.coordinates[len(w.coordinates)-1].next(toPosition, rows, cols)
 
-	if err != nil {
-		return false
+	allFoundWordsList := make([]string, len(results))
+	for i, result := range results {
+		allFoundWordsList[i] = fmt.Sprintf("%s [%d]", result, result.Score.Total)
 	}
+	printColumns(allFoundWordsList)
+	//fmt.Println()
+	//time.Sleep(1000 * time.Millisecond)
+}
 
-	if w.hasVisitedCell(*newCoord) {
-		return false
+// printAggregated agrupa os caminhos por palavra segundo o modo e imprime como sortAndPrint
+func printAggregated(results []PathResult, mode AggregateMode, matrix *LetterMatrix, breakdown bool) {
+	if mode == AggregateAll || len(results) == 0 {
+		sortAndPrint(results, breakdown)
+		return
 	}
 
-	w.word = append(w.word, w.matrix.GetMatrix()[newCoord.X][newCoord.Y])
-	w.coordinates = append(w.coordinates, *newCoord)
-	stringWord := strings.ToUpper(string(w.word))
-	if w.dictionary.IsWord(stringWord) {
-
-		fullPathWalked := ""
-		for _, coord := range w.coordinates {
-			fullPathWalked += fmt.Sprintf("(%d,%d)", coord.X+1, coord.Y+1)
+	aggregated := Aggregate(results, mode, matrix)
+	lines := make([]string, len(aggregated))
+	for i, result := range aggregated {
+		if breakdown {
+			fmt.Printf("%s = %s\n", result.format(mode), result.Score)
+			continue
 		}
-
-		wordAndFullPathWalked := fmt.Sprintf("%s %s", stringWord, fullPathWalked)
-		addFoundWord(wordAndFullPathWalked)
-		return true
+		lines[i] = fmt.Sprintf("%s [%d]", result.format(mode), result.Score.Total)
 	}
-
-	return w.dictionary.IsPrefix(stringWord)
-}
-
-// hasVisitedCell checks if a coordinate was already visited by walking backwards through the path
-func (w *Word) hasVisitedCell(coord Coord) bool {
-	// Walk backwards through the coordinates to check for repeated visits
-	for i := len(w.coordinates) - 1; i >= 0; i-- {
-		if w.coordinates[i].X == coord.X && w.coordinates[i].Y == coord.Y {
-			return true
-		}
+	if !breakdown {
+		printColumns(lines)
 	}
-	return false
 }
 
-func sortAndPrint(allFoundWordsList []string, preferredCoordinates []string) {
-	if len(allFoundWordsList) == 0 {
-		fmt.Println("No words found...")
-		return
-	}
-
-	sort.Slice(allFoundWordsList, func(i, j int) bool {
-
-		found_i := 0
-		found_j := 0
-		for _, coord := range preferredCoordinates {
-			if strings.Contains(allFoundWordsList[i], coord) {
-				found_i++
-			}
-			if strings.Contains(allFoundWordsList[j], coord) {
-				found_j++
-			}
-		}
-		if found_i > found_j {
-			return true
-		}
-		if found_i < found_j {
-			return false
-		}
-		return len(allFoundWordsList[i]) > len(allFoundWordsList[j])
-	})
+// printColumns imprime as linhas em até três colunas conforme o comprimento
+func printColumns(allFoundWordsList []string) {
 	maxLength := len(allFoundWordsList[0])
 	count := 0
 	for _, word := range allFoundWordsList {
@@ -290,6 +415,4 @@ func sortAndPrint(allFoundWordsList []string, preferredCoordinates []string) {
 		count++
 	}
 	fmt.Println()
-	//fmt.Println()
-	//time.Sleep(1000 * time.Millisecond)
 }
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"errors"
+	"io/fs"
 	"os"
 	"strings"
 	"testing"
@@ -190,6 +192,14 @@ func TestEdgeCases(t *testing.T) {
 	}
 }
 
+// TestLoadBoardsMissingFile tests that a missing matrix file is returned as an error
+func TestLoadBoardsMissingFile(t *testing.T) {
+	_, err := loadBoards("does-not-exist.txt", "", false)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected a not-exist error, got %v", err)
+	}
+}
+
 // BenchmarkWordSearch benchmarks the word search performance
 func BenchmarkWordSearch(b *testing.B) {
 	// Create a test matrix and dictionary for benchmarking
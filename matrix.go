@@ -3,6 +3,8 @@ package main
 import (
 	"bufio"
 	"fmt"
+	"io"
+	"math/rand/v2"
 	"os"
 	"strings"
 	"unicode"
@@ -15,6 +17,11 @@ type LetterMatrix struct {
 	cols            int
 	specials        []string
 	special_letters []SpecialLetter
+	gravity         Gravity
+	refillSource    *rand.PCG
+	meta            BoardMeta
+	adjacency       Adjacency
+	bends           BendOptions
 }
 
 type SpecialType int
@@ -29,7 +36,8 @@ type SpecialLetter struct {
 	coordinate   Coord
 }
 
-// NewLetterMatrixFromFile cria uma nova matriz de letras a partir de um arquivo
+// NewLetterMatrixFromFile cria uma nova matriz de letras a partir de um arquivo,
+// no formato simples, com cabeçalho "---" ou JSON
 func NewLetterMatrixFromFile(filename string) (*LetterMatrix, error) {
 	file, err := os.Open(filename)
 	if err != nil {
@@ -37,30 +45,36 @@ func NewLetterMatrixFromFile(filename string) (*LetterMatrix, error) {
 	}
 	defer file.Close()
 
-	var matrix [][]rune
-	var maxCols int
-	scanner := bufio.NewScanner(file)
+	return NewLetterMatrixFromReader(file)
+}
+
+// NewLetterMatrixFromReader cria uma matriz a partir de um leitor (arquivo ou entrada padrão)
+func NewLetterMatrixFromReader(r io.Reader) (*LetterMatrix, error) {
+	lines, err := readBoardLines(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseBoard(lines)
+}
+
+// NewLetterMatrixFromGrid cria uma matriz a partir de uma linha como "abc/def/ghi"
+func NewLetterMatrixFromGrid(grid string) (*LetterMatrix, error) {
+	return parsePlainBoard(strings.Split(grid, "/"), BoardMeta{})
+}
+
+func readBoardLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, MAX_BOARD_LINE)
 
 	for scanner.Scan() {
-		line := scanner.Text()
-		if line != "" {
-			row := []rune(line)
-			if len(row) > maxCols {
-				maxCols = len(row)
-			}
-			matrix = append(matrix, row)
-		}
+		lines = append(lines, scanner.Text())
 	}
 
 	if err := scanner.Err(); err != nil {
 		return nil, fmt.Errorf("%s da matriz: %w", ErrFileRead, err)
 	}
-
-	if len(matrix) == 0 {
-		return nil, ErrEmptyMatrix
-	}
-
-	return newLetterMatrixFromRuneMatrix(matrix, maxCols), nil
+	return lines, nil
 }
 
 func NewLetterMatrixFromString(matrixString string) (*LetterMatrix, error) {
@@ -110,6 +124,7 @@ func newLetterMatrixFromRuneMatrix(matrix [][]rune, maxCols int) *LetterMatrix {
 		rows:     len(matrix),
 		cols:     len(matrix[0]),
 		specials: specialCellsCoordStrings,
+		gravity:  DefaultGravity,
 	}
 }
 
@@ -123,52 +138,113 @@ func (lm *LetterMatrix) GetDimensions() (int, int) {
 	return lm.rows, lm.cols
 }
 
-// PrintMatrix imprime a matriz de letras
-func (lm *LetterMatrix) PrintMatrix() {
-	fmt.Println("Matriz de Letras:")
-	fmt.Printf("Dimensões: %dx%d\n\n", lm.rows, lm.cols)
+// Clone retorna uma cópia independente da matriz, incluindo o estado da gravidade
+func (lm *LetterMatrix) Clone() *LetterMatrix {
+	clone := *lm
+	clone.matrix = make([][]rune, len(lm.matrix))
+	for i, row := range lm.matrix {
+		clone.matrix[i] = append([]rune(nil), row...)
+	}
+	clone.specials = append([]string(nil), lm.specials...)
+	clone.special_letters = append([]SpecialLetter(nil), lm.special_letters...)
+	if lm.refillSource != nil {
+		source := *lm.refillSource
+		clone.refillSource = &source
+	}
+	return &clone
+}
 
+// String retorna as linhas da matriz separadas por quebra de linha
+func (lm *LetterMatrix) String() string {
+	var sb strings.Builder
 	for i, row := range lm.matrix {
-		fmt.Printf("%2d: %s\n", i, string(row))
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(string(row))
 	}
+	return sb.String()
 }
 
-// RemoverLetras remove as letras e reorganiza a matriz
-func (lm *LetterMatrix) RemoveLetters(coordinates []Coord) {
-	for _, coord := range coordinates {
-		lm.matrix[coord.X][coord.Y] = '-'
-		// Verificar se está removendo letra especial
-		for _, special := range lm.specials {
-			if strings.Contains(special, fmt.Sprintf("(%d,%d)", coord.X+1, coord.Y+1)) {
-				lm.specials = removeStringFromSlice(lm.specials, special)
+// MASK_CELL marca no arquivo uma célula bloqueada, que não faz parte da matriz
+// (formas irregulares como losangos e círculos). Diferente de ' ', que é uma célula vazia.
+const MASK_CELL = '#'
+
+// isLetter indica se a célula contém uma letra (nem vazia nem bloqueada)
+func isLetter(cell rune) bool {
+	return cell != ' ' && cell != MASK_CELL
+}
+
+// CountLetters conta as células ocupadas por letras
+func (lm *LetterMatrix) CountLetters() int {
+	count := 0
+	for _, row := range lm.matrix {
+		for _, cell := range row {
+			if isLetter(cell) {
+				count++
 			}
 		}
 	}
-	// collapse Y moving down the letters
-	for i := 0; i < lm.rows; i++ {
-		for j := 0; j < lm.cols; j++ {
-			if lm.matrix[i][j] == '-' {
-				for k := i; k > 0; k-- {
-					// Verificar se letra especial se moveu
-					for _, special := range lm.specials {
-						if strings.Contains(special, fmt.Sprintf("(%d,%d)", k-1+1, j+1)) {
-							lm.specials = removeStringFromSlice(lm.specials, special)
-							lm.specials = append(lm.specials, fmt.Sprintf("(%d,%d)", k+1, j+1))
-						}
-					}
-					lm.matrix[k][j] = lm.matrix[k-1][j]
-				}
-				lm.matrix[0][j] = ' '
+	return count
+}
+
+// CountMasked conta as células bloqueadas pela máscara
+func (lm *LetterMatrix) CountMasked() int {
+	count := 0
+	for _, row := range lm.matrix {
+		for _, cell := range row {
+			if cell == MASK_CELL {
+				count++
 			}
 		}
 	}
+	return count
 }
 
-func removeStringFromSlice(s []string, special string) []string {
-	for i, v := range s {
-		if v == special {
-			return append(s[:i], s[i+1:]...)
+// IsSpecial indica se a coordenada é uma célula especial
+func (lm *LetterMatrix) IsSpecial(coord Coord) bool {
+	key := fmt.Sprintf("(%d,%d)", coord.X+1, coord.Y+1)
+	for _, special := range lm.specials {
+		if special == key {
+			return true
 		}
 	}
-	return s
+	return false
+}
+
+// CountSpecials conta quantas células especiais o caminho toca
+func (lm *LetterMatrix) CountSpecials(path []Coord) int {
+	count := 0
+	for _, coord := range path {
+		if lm.IsSpecial(coord) {
+			count++
+		}
+	}
+	return count
+}
+
+// PrintMatrix imprime a matriz de letras
+func (lm *LetterMatrix) PrintMatrix() {
+	fmt.Println("Matriz de Letras:")
+	fmt.Printf("Dimensões: %dx%d\n", lm.rows, lm.cols)
+	if masked := lm.CountMasked(); masked > 0 {
+		fmt.Printf("Células bloqueadas: %d\n", masked)
+	}
+	fmt.Println()
+
+	for i, row := range lm.matrix {
+		fmt.Printf("%2d: %s\n", i, string(row))
+	}
+}
+
+// RemoveLetters remove as letras e reorganiza a matriz conforme a gravidade configurada
+func (lm *LetterMatrix) RemoveLetters(coordinates []Coord) {
+	removed := make([][]bool, lm.rows)
+	for i := range removed {
+		removed[i] = make([]bool, lm.cols)
+	}
+	for _, coord := range coordinates {
+		removed[coord.X][coord.Y] = true
+	}
+	lm.applyGravity(removed)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 	"strings"
 	"testing"
@@ -162,3 +163,57 @@ func TestRemoveLetters(t *testing.T) {
 		t.Errorf("Expected last row to be 'GBI', got '%s'", lastRow)
 	}
 }
+
+// TestNewLetterMatrixFromGrid tests inline grids with '/' separating the rows
+func TestNewLetterMatrixFromGrid(t *testing.T) {
+	matrix, err := NewLetterMatrixFromGrid("abc/dE/ghi")
+	if err != nil {
+		t.Fatalf("Failed to parse grid: %v", err)
+	}
+	if matrix.String() != "abc\ndE \nghi" {
+		t.Errorf("Unexpected grid %q", matrix.String())
+	}
+	if !matrix.IsSpecial(Coord{X: 1, Y: 1}) {
+		t.Error("Uppercase cell should be special")
+	}
+	if _, err := NewLetterMatrixFromGrid(""); err != ErrEmptyMatrix {
+		t.Errorf("Expected ErrEmptyMatrix, got %v", err)
+	}
+}
+
+// TestNewLetterMatrixFromReader tests loading a matrix from a reader such as stdin
+func TestNewLetterMatrixFromReader(t *testing.T) {
+	matrix, err := NewLetterMatrixFromReader(strings.NewReader("abc\ndef\n"))
+	if err != nil {
+		t.Fatalf("Failed to read matrix: %v", err)
+	}
+	if rows, cols := matrix.GetDimensions(); rows != 2 || cols != 3 {
+		t.Errorf("Expected 2x3, got %dx%d", rows, cols)
+	}
+}
+
+// TestMaskedBoard tests that masked cells are kept apart from letters and empty cells
+func TestMaskedBoard(t *testing.T) {
+	matrix, err := NewLetterMatrixFromGrid("#a#/bcd/#e#")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	if got := matrix.CountLetters(); got != 5 {
+		t.Errorf("Expected 5 letters, got %d", got)
+	}
+	if got := matrix.CountMasked(); got != 4 {
+		t.Errorf("Expected 4 masked cells, got %d", got)
+	}
+
+	var sb strings.Builder
+	if err := matrix.WriteBoard(&sb); err != nil {
+		t.Fatalf("WriteBoard failed: %v", err)
+	}
+	if sb.String() != "#a#\nbcd\n#e#\n" {
+		t.Errorf("Expected the mask to round-trip, got %q", sb.String())
+	}
+
+	if _, err := parseBoard([]string{"---", "multiplier: 1,1 2W", "---", "#a#", "bcd"}); !errors.Is(err, ErrInvalidHeader) {
+		t.Errorf("Expected ErrInvalidHeader for a multiplier on a masked cell, got %v", err)
+	}
+}
@@ -7,6 +7,8 @@ type WordResult struct {
 	StartCol  int
 	Direction string
 	Length    int
+	Path      []Coord
+	Score     Score
 }
 
 // Direction representa uma direção de busca
@@ -29,5 +31,9 @@ type Word struct {
 	coordinates []Coord
 	matrix      *LetterMatrix
 	dictionary  *Dictionary
-	directions  *[]string
+	adjacency   Adjacency
+	remaining   letterBag
+	// staticLetters mantém remaining com todas as letras da matriz em vez de descontar as do caminho
+	staticLetters bool
+	collector     *pathCollector
 }
@@ -0,0 +1,372 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidMove = errors.New("jogada inválida")
+	ErrNothingToDo = errors.New("nada para desfazer/refazer")
+)
+
+// DEFAULT_PLAY_TOP é o número de candidatas exibidas por rodada
+const DEFAULT_PLAY_TOP = 15
+
+var pathCoordPattern = regexp.MustCompile(`\((\d+),(\d+)\)`)
+
+// PlaySession mantém o estado de uma partida interativa
+type PlaySession struct {
+	matrix     *LetterMatrix
+	dictionary *Dictionary
+	scorer     Scorer
+	initial    *LetterMatrix
+	// history são os estados anteriores a cada jogada aplicada; redo guarda os desfeitos
+	history    []playStep
+	redo       []playStep
+	candidates []PathResult
+	top        int
+}
+
+type playStep struct {
+	before *LetterMatrix
+	move   PathResult
+}
+
+// NewPlaySession cria uma partida a partir da matriz informada
+func NewPlaySession(matrix *LetterMatrix, dictionary *Dictionary, scorer Scorer) *PlaySession {
+	ps := &PlaySession{
+		matrix:     matrix,
+		dictionary: dictionary,
+		scorer:     scorer,
+		initial:    matrix.Clone(),
+		top:        DEFAULT_PLAY_TOP,
+	}
+	ps.refresh()
+	return ps
+}
+
+// refresh recalcula as palavras candidatas para a matriz atual
+func (ps *PlaySession) refresh() {
+	searcher := NewPathSearcher(ps.matrix, ps.dictionary)
+	searcher.SetScorer(ps.scorer)
+	ps.candidates = searcher.SearchAllWords()
+	sortPathResults(ps.candidates)
+}
+
+// Candidates retorna as palavras disponíveis, ordenadas pela pontuação
+func (ps *PlaySession) Candidates() []PathResult {
+	return ps.candidates
+}
+
+// Apply aplica a jogada, removendo as letras do caminho
+func (ps *PlaySession) Apply(move PathResult) {
+	ps.history = append(ps.history, playStep{before: ps.matrix.Clone(), move: move})
+	ps.redo = ps.redo[:0]
+	ps.matrix.RemoveLetters(move.Coordinates)
+	ps.refresh()
+}
+
+// Undo desfaz a última jogada
+func (ps *PlaySession) Undo() (PathResult, error) {
+	if len(ps.history) == 0 {
+		return PathResult{}, ErrNothingToDo
+	}
+	step := ps.history[len(ps.history)-1]
+	ps.history = ps.history[:len(ps.history)-1]
+	ps.redo = append(ps.redo, playStep{before: step.before, move: step.move})
+	ps.matrix = step.before.Clone()
+	ps.refresh()
+	return step.move, nil
+}
+
+// Redo refaz a última jogada desfeita
+func (ps *PlaySession) Redo() (PathResult, error) {
+	if len(ps.redo) == 0 {
+		return PathResult{}, ErrNothingToDo
+	}
+	step := ps.redo[len(ps.redo)-1]
+	ps.redo = ps.redo[:len(ps.redo)-1]
+	ps.history = append(ps.history, step)
+	ps.matrix = step.before.Clone()
+	ps.matrix.RemoveLetters(step.move.Coordinates)
+	ps.refresh()
+	return step.move, nil
+}
+
+// Moves retorna as jogadas aplicadas até agora
+func (ps *PlaySession) Moves() []PathResult {
+	moves := make([]PathResult, len(ps.history))
+	for i, step := range ps.history {
+		moves[i] = step.move
+	}
+	return moves
+}
+
+// ResolveMove interpreta a entrada do usuário: número da candidata, palavra ou caminho (linha,coluna)
+func (ps *PlaySession) ResolveMove(input string) (PathResult, error) {
+	if n, err := strconv.Atoi(input); err == nil {
+		if n < 1 || n > len(ps.candidates) {
+			return PathResult{}, fmt.Errorf("%w: candidata %d não existe", ErrInvalidMove, n)
+		}
+		return ps.candidates[n-1], nil
+	}
+
+	if strings.HasPrefix(input, "(") {
+		return ps.resolvePath(input)
+	}
+
+	word := strings.ToUpper(input)
+	for _, candidate := range ps.candidates {
+		if candidate.Word == word {
+			return candidate, nil
+		}
+	}
+	return PathResult{}, fmt.Errorf("%w: %s não pode ser formada", ErrInvalidMove, word)
+}
+
+func (ps *PlaySession) resolvePath(input string) (PathResult, error) {
+	matches := pathCoordPattern.FindAllStringSubmatch(input, -1)
+	if len(matches) == 0 {
+		return PathResult{}, fmt.Errorf("%w: caminho vazio", ErrInvalidMove)
+	}
+
+	rows, cols := ps.matrix.GetDimensions()
+	walk := Word{matrix: ps.matrix}
+	for _, match := range matches {
+		row, _ := strconv.Atoi(match[1])
+		col, _ := strconv.Atoi(match[2])
+		coord := Coord{X: row - 1, Y: col - 1}
+		if coord.X < 0 || coord.X >= rows || coord.Y < 0 || coord.Y >= cols || !isLetter(ps.matrix.GetMatrix()[coord.X][coord.Y]) {
+			return PathResult{}, fmt.Errorf("%w: célula (%d,%d) vazia ou fora da matriz", ErrInvalidMove, row, col)
+		}
+		if walk.hasVisitedCell(coord) {
+			return PathResult{}, fmt.Errorf("%w: célula (%d,%d) repetida", ErrInvalidMove, row, col)
+		}
+		if len(walk.coordinates) > 0 {
+			last := walk.coordinates[len(walk.coordinates)-1]
+			if !IsAdjacent(ps.matrix.GetAdjacency(), last, coord, rows, cols) {
+				return PathResult{}, fmt.Errorf("%w: (%d,%d) não é vizinha da célula anterior", ErrInvalidMove, row, col)
+			}
+		}
+		walk.coordinates = append(walk.coordinates, coord)
+		walk.word = append(walk.word, unicode.ToUpper(ps.matrix.GetMatrix()[coord.X][coord.Y]))
+	}
+
+	word := string(walk.word)
+	if !ps.dictionary.IsWord(word) {
+		return PathResult{}, fmt.Errorf("%w: %s não está no dicionário", ErrInvalidMove, word)
+	}
+	return PathResult{
+		Word:        word,
+		Coordinates: walk.coordinates,
+		Score:       ps.scorer.Score(word, walk.coordinates, ps.matrix),
+	}, nil
+}
+
+// SaveHistory grava a matriz inicial, as jogadas e a matriz atual
+func (ps *PlaySession) SaveHistory(w io.Writer) error {
+	total := 0
+	fmt.Fprintln(w, "# matriz inicial")
+	fmt.Fprintln(w, ps.initial)
+	fmt.Fprintln(w, "# jogadas")
+	for i, move := range ps.Moves() {
+		total += move.Score.Total
+		fmt.Fprintf(w, "%d. %s [%d]\n", i+1, move, move.Score.Total)
+	}
+	fmt.Fprintf(w, "# pontuação total: %d\n", total)
+	fmt.Fprintln(w, "# matriz atual")
+	_, err := fmt.Fprintln(w, ps.matrix)
+	return err
+}
+
+// Run executa o laço interativo lendo comandos de in e escrevendo em out
+func (ps *PlaySession) Run(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	ps.print(out)
+	for {
+		fmt.Fprint(out, "> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		command, argument, _ := strings.Cut(strings.TrimSpace(scanner.Text()), " ")
+		argument = strings.TrimSpace(argument)
+
+		switch strings.ToLower(command) {
+		case "":
+			continue
+		case "q", "quit", "exit":
+			return nil
+		case "h", "help", "?":
+			printPlayHelp(out)
+		case "b", "board":
+			ps.print(out)
+		case "l", "list":
+			if n, err := strconv.Atoi(argument); err == nil && n > 0 {
+				ps.top = n
+			}
+			ps.printCandidates(out)
+		case "u", "undo":
+			if move, err := ps.Undo(); err != nil {
+				fmt.Fprintln(out, err)
+			} else {
+				fmt.Fprintf(out, "Desfeito: %s\n", move)
+				ps.print(out)
+			}
+		case "r", "redo":
+			if move, err := ps.Redo(); err != nil {
+				fmt.Fprintln(out, err)
+			} else {
+				fmt.Fprintf(out, "Refeito: %s\n", move)
+				ps.print(out)
+			}
+		case "s", "save":
+			if argument == "" {
+				ps.SaveHistory(out)
+				continue
+			}
+			if err := ps.saveHistoryFile(argument); err != nil {
+				fmt.Fprintf(out, "Erro ao salvar: %v\n", err)
+			} else {
+				fmt.Fprintf(out, "Histórico salvo em %s\n", argument)
+			}
+		default:
+			move, err := ps.ResolveMove(strings.TrimSpace(scanner.Text()))
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+			ps.Apply(move)
+			fmt.Fprintf(out, "Jogada: %s [%d]\n", move, move.Score.Total)
+			ps.print(out)
+		}
+	}
+}
+
+func (ps *PlaySession) saveHistoryFile(filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := ps.SaveHistory(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (ps *PlaySession) print(out io.Writer) {
+	fmt.Fprintf(out, "\nJogada %d - %d letras restantes\n", len(ps.history)+1, ps.matrix.CountLetters())
+	for i, row := range ps.matrix.GetMatrix() {
+		fmt.Fprintf(out, "%2d: %s\n", i+1, string(row))
+	}
+	ps.printCandidates(out)
+}
+
+func (ps *PlaySession) printCandidates(out io.Writer) {
+	if len(ps.candidates) == 0 {
+		fmt.Fprintln(out, "Nenhuma palavra disponível. Fim de jogo.")
+		return
+	}
+	for i, candidate := range ps.candidates[:min(ps.top, len(ps.candidates))] {
+		fmt.Fprintf(out, "%3d. %s [%d]\n", i+1, candidate, candidate.Score.Total)
+	}
+	if len(ps.candidates) > ps.top {
+		fmt.Fprintf(out, "... mais %d candidatas (l N para listar)\n", len(ps.candidates)-ps.top)
+	}
+}
+
+func printPlayHelp(out io.Writer) {
+	fmt.Fprintln(out, `Comandos:
+  N               aplica a candidata N
+  PALAVRA         aplica a melhor candidata com a palavra
+  (l,c)(l,c)...   aplica o caminho informado (base 1)
+  l [N]           lista as N melhores candidatas
+  b               mostra a matriz
+  u / r           desfaz / refaz a última jogada
+  s [arquivo]     salva o histórico da partida
+  q               sai`)
+}
+
+// runPlay implementa o subcomando "wordgo play"
+func runPlay(args []string) {
+	flags := flag.NewFlagSet("play", flag.ExitOnError)
+	matrixFile := flags.String("matrix", "res/example_tower.txt", "Arquivo de matriz de letras para carregar")
+	dictFile := flags.String("dict", DEFAULT_DICTIONARY, "Arquivo de dicionário")
+	rules := flags.String("rules", DEFAULT_RULES, "Regras de pontuação: "+strings.Join(RuleSetNames(), ", "))
+	gravitySpec := flags.String("gravity", DefaultGravity.String(), "Gravidade: down|up|left|right|none[+refill][+collapse] (padrão: a do cabeçalho ou down)")
+	seed := flags.Uint64("seed", 1, "Semente para reposição aleatória de letras (padrão: a do cabeçalho ou 1)")
+	top := flags.Int("top", DEFAULT_PLAY_TOP, "Número de candidatas exibidas")
+	adjacencyName := flags.String("adjacency", "", "Vizinhança das células: "+strings.Join(AdjacencyNames(), ", ")+" (padrão: a do cabeçalho ou king)")
+	flags.Parse(args)
+	if *top <= 0 {
+		log.Fatalf("-top deve ser maior que zero: %d", *top)
+	}
+
+	explicit := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+	matrix, err := NewLetterMatrixFromFile(*matrixFile)
+	if err != nil {
+		log.Fatalf("Erro ao carregar matriz: %v", err)
+	}
+
+	// O cabeçalho da matriz define os padrões; flags explícitas têm precedência
+	meta := matrix.GetMeta()
+	if explicit["gravity"] || explicit["seed"] {
+		spec, gravitySeed := *gravitySpec, *seed
+		if !explicit["gravity"] && meta.Gravity != "" {
+			spec = meta.Gravity
+		}
+		if !explicit["seed"] && meta.Seed != 0 {
+			gravitySeed = meta.Seed
+		}
+		gravity, err := ParseGravity(spec, gravitySeed)
+		if err != nil {
+			log.Fatalf("Erro na gravidade: %v", err)
+		}
+		matrix.SetGravity(gravity)
+	}
+	if *adjacencyName != "" {
+		adjacency, err := ParseAdjacency(*adjacencyName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := matrix.SetAdjacency(adjacency); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	boardDict, boardRules := *dictFile, *rules
+	if meta.Dictionary != "" && !explicit["dict"] {
+		boardDict = meta.Dictionary
+	}
+	if meta.Rules != "" && !explicit["rules"] {
+		boardRules = meta.Rules
+	}
+	dict, err := NewDictionary(boardDict)
+	if err != nil {
+		log.Fatalf("Erro ao carregar dicionário: %v", err)
+	}
+	scorer, err := NewScorer(boardRules)
+	if err != nil {
+		log.Fatalf("Erro ao selecionar regras: %v", err)
+	}
+
+	session := NewPlaySession(matrix, dict, scorer)
+	session.top = *top
+	printPlayHelp(os.Stdout)
+	if err := session.Run(os.Stdin, os.Stdout); err != nil {
+		log.Fatalf("Erro na leitura: %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestPlaySession(t *testing.T) *PlaySession {
+	t.Helper()
+	dict := loadTestDictionary(t, "PLANET", "STREAM")
+	matrix, err := NewLetterMatrixFromString("str\npla\nten\nmae")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	scorer, _ := NewScorer(DEFAULT_RULES)
+	return NewPlaySession(matrix, dict, scorer)
+}
+
+// TestPlaySessionUndoRedo tests applying, undoing and redoing moves
+func TestPlaySessionUndoRedo(t *testing.T) {
+	session := newTestPlaySession(t)
+	if len(session.Candidates()) != 1 || session.Candidates()[0].Word != "PLANET" {
+		t.Fatalf("Expected PLANET as only candidate, got %v", session.Candidates())
+	}
+
+	move, err := session.ResolveMove("1")
+	if err != nil {
+		t.Fatalf("ResolveMove failed: %v", err)
+	}
+	session.Apply(move)
+	if session.matrix.CountLetters() != 6 {
+		t.Errorf("Expected 6 letters left, got %d", session.matrix.CountLetters())
+	}
+
+	if _, err := session.Undo(); err != nil {
+		t.Fatalf("Undo failed: %v", err)
+	}
+	if session.matrix.String() != "str\npla\nten\nmae" {
+		t.Errorf("Expected original board after undo, got %q", session.matrix.String())
+	}
+	if _, err := session.Undo(); !errors.Is(err, ErrNothingToDo) {
+		t.Errorf("Expected ErrNothingToDo, got %v", err)
+	}
+
+	if _, err := session.Redo(); err != nil {
+		t.Fatalf("Redo failed: %v", err)
+	}
+	if len(session.Candidates()) != 1 || session.Candidates()[0].Word != "STREAM" {
+		t.Errorf("Expected STREAM after redo, got %v", session.Candidates())
+	}
+}
+
+// TestPlaySessionResolveMove tests word and path inputs
+func TestPlaySessionResolveMove(t *testing.T) {
+	session := newTestPlaySession(t)
+
+	if move, err := session.ResolveMove("planet"); err != nil || move.Word != "PLANET" {
+		t.Errorf("Expected PLANET by word, got %v (%v)", move, err)
+	}
+	move, err := session.ResolveMove("(2,1)(2,2)(2,3)(3,3)(3,2)(3,1)")
+	if err != nil || move.Word != "PLANET" {
+		t.Errorf("Expected PLANET by path, got %v (%v)", move, err)
+	}
+
+	invalid := []string{"stream", "9", "(3,1)(3,3)", "(3,1)(3,1)", "(9,9)", "(3,1)(3,2)(3,3)(4,3)(4,2)"}
+	for _, input := range invalid {
+		if _, err := session.ResolveMove(input); !errors.Is(err, ErrInvalidMove) {
+			t.Errorf("ResolveMove(%q): expected ErrInvalidMove, got %v", input, err)
+		}
+	}
+}
+
+// TestPlaySessionRun tests the interactive loop with scripted input
+func TestPlaySessionRun(t *testing.T) {
+	session := newTestPlaySession(t)
+	historyFile := filepath.Join(t.TempDir(), "history.txt")
+
+	input := strings.Join([]string{"1", "u", "r", "stream", "s " + historyFile, "q"}, "\n")
+	var out bytes.Buffer
+	if err := session.Run(strings.NewReader(input), &out); err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if !strings.Contains(out.String(), "Fim de jogo") {
+		t.Errorf("Expected game over message, got:\n%s", out.String())
+	}
+	history, err := os.ReadFile(historyFile)
+	if err != nil {
+		t.Fatalf("Failed to read history: %v", err)
+	}
+	if !strings.Contains(string(history), "1. PLANET") || !strings.Contains(string(history), "2. STREAM") {
+		t.Errorf("Unexpected history:\n%s", history)
+	}
+}
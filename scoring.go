@@ -0,0 +1,285 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var ErrUnknownRules = errors.New("conjunto de regras desconhecido")
+
+// DEFAULT_RULES é o conjunto de regras usado quando -rules não é informado
+const DEFAULT_RULES = "default"
+
+// ScoreItem representa a contribuição de uma regra para a pontuação final
+type ScoreItem struct {
+	Rule   string `json:"rule"`
+	Points int    `json:"points"`
+}
+
+// Score representa a pontuação calculada de uma palavra e seu detalhamento
+type Score struct {
+	Total     int
+	Breakdown []ScoreItem
+}
+
+// String retorna o detalhamento no formato "regra=pontos, ..."
+func (s Score) String() string {
+	parts := make([]string, 0, len(s.Breakdown))
+	for _, item := range s.Breakdown {
+		parts = append(parts, fmt.Sprintf("%s=%+d", item.Rule, item.Points))
+	}
+	return fmt.Sprintf("%d (%s)", s.Total, strings.Join(parts, ", "))
+}
+
+// Scorer calcula a pontuação de uma palavra encontrada em um caminho da matriz
+type Scorer interface {
+	Name() string
+	Score(word string, path []Coord, matrix *LetterMatrix) Score
+}
+
+// Rule é uma regra de pontuação; regras são aplicadas em ordem e podem ler o total parcial
+type Rule interface {
+	Apply(word []rune, path []Coord, matrix *LetterMatrix, score *Score)
+}
+
+// BoundedRule é uma regra que sabe limitar sua contribuição: Bound recebe o limite do total
+// parcial e retorna o limite após a regra, para palavras de até length letras
+type BoundedRule interface {
+	Bound(total, length int, matrix *LetterMatrix) int
+}
+
+// ScoreBounder é um Scorer capaz de limitar a pontuação de palavras de até length letras.
+// ok é false quando alguma regra não pode ser limitada.
+type ScoreBounder interface {
+	MaxScore(length int, matrix *LetterMatrix) (bound int, ok bool)
+}
+
+// RuleSet é um Scorer composto por uma sequência de regras
+type RuleSet struct {
+	name  string
+	rules []Rule
+}
+
+func (rs *RuleSet) Name() string {
+	return rs.name
+}
+
+func (rs *RuleSet) MaxScore(length int, matrix *LetterMatrix) (int, bool) {
+	bound := 0
+	for _, rule := range rs.rules {
+		bounded, ok := rule.(BoundedRule)
+		if !ok {
+			return 0, false
+		}
+		bound = bounded.Bound(bound, length, matrix)
+	}
+	return bound, true
+}
+
+func (rs *RuleSet) Score(word string, path []Coord, matrix *LetterMatrix) Score {
+	score := Score{Breakdown: make([]ScoreItem, 0, len(rs.rules))}
+	upped := []rune(strings.ToUpper(word))
+	for _, rule := range rs.rules {
+		rule.Apply(upped, path, matrix, &score)
+	}
+	return score
+}
+
+// LengthRule soma um ponto por letra
+type LengthRule struct{}
+
+func (LengthRule) Apply(word []rune, _ []Coord, _ *LetterMatrix, score *Score) {
+	score.add("length", len(word))
+}
+
+func (LengthRule) Bound(total, length int, _ *LetterMatrix) int {
+	return total + length
+}
+
+// SpecialsRule soma Points por célula especial tocada pelo caminho
+type SpecialsRule struct {
+	Points int
+}
+
+func (r SpecialsRule) Apply(_ []rune, path []Coord, matrix *LetterMatrix, score *Score) {
+	if touched := matrix.CountSpecials(path); touched > 0 {
+		score.add("specials", touched*r.Points)
+	}
+}
+
+func (r SpecialsRule) Bound(total, length int, matrix *LetterMatrix) int {
+	return total + max(r.Points, 0)*min(length, len(matrix.specials))
+}
+
+// LetterValueRule soma o valor de cada letra segundo a tabela Values,
+// aplicando os multiplicadores de letra (L) das células do caminho.
+// Peças coringa (WILDCARD_TILE) valem zero, como as peças em branco do Scrabble.
+type LetterValueRule struct {
+	Values map[rune]int
+}
+
+func (r LetterValueRule) Apply(word []rune, path []Coord, matrix *LetterMatrix, score *Score) {
+	points := 0
+	for i, char := range word {
+		value := r.Values[unicode.ToUpper(char)]
+		if matrix != nil && i < len(path) {
+			if matrix.matrix[path[i].X][path[i].Y] == WILDCARD_TILE {
+				continue
+			}
+			if multiplier, ok := matrix.GetMultiplier(path[i]); ok && multiplier.Kind == 'L' {
+				value *= multiplier.Factor
+			}
+		}
+		points += value
+	}
+	score.add("letters", points)
+}
+
+func (r LetterValueRule) Bound(total, length int, matrix *LetterMatrix) int {
+	value, factor := 0, 1
+	for _, v := range r.Values {
+		value = max(value, v)
+	}
+	for _, multiplier := range matrix.meta.Multipliers {
+		if multiplier.Kind == 'L' {
+			factor = max(factor, multiplier.Factor)
+		}
+	}
+	return total + length*value*factor
+}
+
+// LengthBonusRule soma um bônus fixo conforme o comprimento da palavra.
+// Bonus[i] vale para palavras com i letras; comprimentos maiores usam o último valor.
+type LengthBonusRule struct {
+	Bonus []int
+}
+
+func (r LengthBonusRule) Apply(word []rune, _ []Coord, _ *LetterMatrix, score *Score) {
+	if len(r.Bonus) == 0 {
+		return
+	}
+	index := min(len(word), len(r.Bonus)-1)
+	if r.Bonus[index] != 0 {
+		score.add("length bonus", r.Bonus[index])
+	}
+}
+
+func (r LengthBonusRule) Bound(total, length int, _ *LetterMatrix) int {
+	if len(r.Bonus) == 0 {
+		return total
+	}
+	return total + max(0, slices.Max(r.Bonus[:min(length, len(r.Bonus)-1)+1]))
+}
+
+// SpecialMultiplierRule multiplica o total parcial por Factor a cada célula especial tocada
+type SpecialMultiplierRule struct {
+	Factor int
+}
+
+func (r SpecialMultiplierRule) Apply(_ []rune, path []Coord, matrix *LetterMatrix, score *Score) {
+	touched := matrix.CountSpecials(path)
+	if touched == 0 || r.Factor <= 1 {
+		return
+	}
+	multiplied := score.Total
+	for range touched {
+		multiplied *= r.Factor
+	}
+	score.add(fmt.Sprintf("x%d specials", r.Factor), multiplied-score.Total)
+}
+
+func (r SpecialMultiplierRule) Bound(total, length int, matrix *LetterMatrix) int {
+	for range min(length, len(matrix.specials)) {
+		total *= max(r.Factor, 1)
+	}
+	return total
+}
+
+// TileWordMultiplierRule multiplica o total parcial pelos multiplicadores de palavra (W) do caminho
+type TileWordMultiplierRule struct{}
+
+func (TileWordMultiplierRule) Apply(_ []rune, path []Coord, matrix *LetterMatrix, score *Score) {
+	if matrix == nil {
+		return
+	}
+	factor := 1
+	for _, coord := range path {
+		if multiplier, ok := matrix.GetMultiplier(coord); ok && multiplier.Kind == 'W' {
+			factor *= multiplier.Factor
+		}
+	}
+	if factor > 1 {
+		score.add(fmt.Sprintf("x%d word tiles", factor), score.Total*(factor-1))
+	}
+}
+
+// Bound multiplica por todos os multiplicadores de palavra da matriz, já que cada célula é usada uma vez
+func (TileWordMultiplierRule) Bound(total, _ int, matrix *LetterMatrix) int {
+	for _, multiplier := range matrix.meta.Multipliers {
+		if multiplier.Kind == 'W' {
+			total *= multiplier.Factor
+		}
+	}
+	return total
+}
+
+func (s *Score) add(rule string, points int) {
+	s.Total += points
+	s.Breakdown = append(s.Breakdown, ScoreItem{Rule: rule, Points: points})
+}
+
+// scrabbleLetterValues é a tabela de valores das letras do Scrabble em inglês
+var scrabbleLetterValues = map[rune]int{
+	'A': 1, 'E': 1, 'I': 1, 'O': 1, 'U': 1, 'L': 1, 'N': 1, 'S': 1, 'T': 1, 'R': 1,
+	'D': 2, 'G': 2,
+	'B': 3, 'C': 3, 'M': 3, 'P': 3,
+	'F': 4, 'H': 4, 'V': 4, 'W': 4, 'Y': 4,
+	'K': 5,
+	'J': 8, 'X': 8,
+	'Q': 10, 'Z': 10,
+}
+
+// ruleSets contém os conjuntos de regras disponíveis via -rules
+var ruleSets = map[string]func() Scorer{
+	// default reproduz a ordenação original: especiais tocadas primeiro, depois comprimento
+	"default": func() Scorer {
+		return &RuleSet{name: "default", rules: []Rule{SpecialsRule{Points: 100}, LengthRule{}}}
+	},
+	"scrabble": func() Scorer {
+		return &RuleSet{name: "scrabble", rules: []Rule{
+			LetterValueRule{Values: scrabbleLetterValues},
+			TileWordMultiplierRule{},
+			SpecialMultiplierRule{Factor: 2},
+		}}
+	},
+	"boggle": func() Scorer {
+		return &RuleSet{name: "boggle", rules: []Rule{
+			LengthBonusRule{Bonus: []int{0, 0, 0, 1, 1, 2, 3, 5, 11}},
+			TileWordMultiplierRule{},
+			SpecialMultiplierRule{Factor: 2},
+		}}
+	},
+}
+
+// NewScorer retorna o conjunto de regras registrado com o nome informado
+func NewScorer(name string) (Scorer, error) {
+	factory, ok := ruleSets[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (disponíveis: %s)", ErrUnknownRules, name, strings.Join(RuleSetNames(), ", "))
+	}
+	return factory(), nil
+}
+
+// RuleSetNames retorna os nomes dos conjuntos de regras em ordem alfabética
+func RuleSetNames() []string {
+	names := make([]string, 0, len(ruleSets))
+	for name := range ruleSets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestScorerRuleSets tests the built-in rule sets against known values
+func TestScorerRuleSets(t *testing.T) {
+	matrix, err := NewLetterMatrixFromString("plaNet")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	path := []Coord{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {0, 4}, {0, 5}}
+
+	testCases := []struct {
+		rules    string
+		expected int
+	}{
+		{"default", 100 + 6}, // one special touched, six letters
+		{"scrabble", (3 + 1 + 1 + 1 + 1 + 1) * 2},
+		{"boggle", 3 * 2},
+	}
+
+	for _, tc := range testCases {
+		scorer, err := NewScorer(tc.rules)
+		if err != nil {
+			t.Fatalf("NewScorer(%q) failed: %v", tc.rules, err)
+		}
+		score := scorer.Score("planet", path, matrix)
+		if score.Total != tc.expected {
+			t.Errorf("%s: expected %d, got %s", tc.rules, tc.expected, score)
+		}
+
+		sum := 0
+		for _, item := range score.Breakdown {
+			sum += item.Points
+		}
+		if sum != score.Total {
+			t.Errorf("%s: breakdown sums to %d, total is %d", tc.rules, sum, score.Total)
+		}
+	}
+}
+
+// TestNewScorerUnknown tests that unknown rule sets are rejected
+func TestNewScorerUnknown(t *testing.T) {
+	if _, err := NewScorer("chess"); !errors.Is(err, ErrUnknownRules) {
+		t.Errorf("Expected ErrUnknownRules, got %v", err)
+	}
+}
@@ -1 +1,301 @@
 package main
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// PathResult representa uma palavra encontrada caminhando pelas células adjacentes
+type PathResult struct {
+	Word        string
+	Coordinates []Coord
+	Score       Score
+}
+
+// Path retorna o caminho no formato (linha,coluna) com base 1
+func (r PathResult) Path() string {
+	var sb strings.Builder
+	for _, coord := range r.Coordinates {
+		fmt.Fprintf(&sb, "(%d,%d)", coord.X+1, coord.Y+1)
+	}
+	return sb.String()
+}
+
+// String retorna a palavra seguida do caminho, formato usado na saída e como chave única
+func (r PathResult) String() string {
+	return r.Word + " " + r.Path()
+}
+
+// PathSearcher busca palavras caminhando livremente pelas células vizinhas sem repetir células
+type PathSearcher struct {
+	matrix     *LetterMatrix
+	dictionary *Dictionary
+	scorer     Scorer
+	// staticLetters poda só pelas letras da matriz, sem descontar as já usadas no caminho;
+	// existe para os benchmarks medirem o ganho da contagem
+	staticLetters bool
+}
+
+// pathCollector acumula os caminhos encontrados de forma thread-safe
+type pathCollector struct {
+	found map[string]PathResult
+	mutex sync.Mutex
+	// emit recebe cada caminho novo assim que é encontrado; retornar false interrompe a busca
+	emit    func(PathResult) bool
+	stopped atomic.Bool
+}
+
+// NewPathSearcher cria um novo buscador por caminhos
+func NewPathSearcher(matrix *LetterMatrix, dictionary *Dictionary) *PathSearcher {
+	scorer, _ := NewScorer(DEFAULT_RULES)
+	return &PathSearcher{
+		matrix:     matrix,
+		dictionary: dictionary,
+		scorer:     scorer,
+	}
+}
+
+// SetScorer define as regras de pontuação aplicadas aos resultados
+func (ps *PathSearcher) SetScorer(scorer Scorer) {
+	ps.scorer = scorer
+}
+
+// SearchFromPosition retorna todos os caminhos que formam palavras a partir da célula informada
+func (ps *PathSearcher) SearchFromPosition(startRow, startCol int) []PathResult {
+	return ps.searchFromPosition(startRow, startCol, nil)
+}
+
+func (ps *PathSearcher) searchFromPosition(startRow, startCol int, emit func(PathResult) bool) []PathResult {
+	if !isLetter(ps.matrix.GetMatrix()[startRow][startCol]) {
+		return nil
+	}
+
+	collector := &pathCollector{found: make(map[string]PathResult)}
+	if emit != nil {
+		collector.emit = func(result PathResult) bool {
+			result.Score = ps.scorer.Score(result.Word, result.Coordinates, ps.matrix)
+			return emit(result)
+		}
+	}
+	start := Word{
+		word:          []rune{ps.matrix.GetMatrix()[startRow][startCol]},
+		coordinates:   []Coord{{X: startRow, Y: startCol}},
+		matrix:        ps.matrix,
+		dictionary:    ps.dictionary,
+		adjacency:     ps.matrix.GetAdjacency(),
+		remaining:     ps.matrix.LetterBag(),
+		staticLetters: ps.staticLetters,
+		collector:     collector,
+	}
+	if !start.staticLetters {
+		start.remaining.take(start.word[0])
+	}
+
+	var wg sync.WaitGroup
+	limitGoroutines := make(chan struct{}, MAX_GOROUTINES)
+	wg.Go(func() {
+		toWalk(start, limitGoroutines)
+	})
+	wg.Wait()
+
+	results := make([]PathResult, 0, len(collector.found))
+	for _, result := range collector.found {
+		result.Score = ps.scorer.Score(result.Word, result.Coordinates, ps.matrix)
+		results = append(results, result)
+	}
+	return results
+}
+
+// Stream percorre todas as células como ponto de partida e entrega cada caminho assim que é
+// encontrado. Sair do laço ou cancelar o contexto interrompe a busca.
+func (ps *PathSearcher) Stream(ctx context.Context) iter.Seq[PathResult] {
+	return func(yield func(PathResult) bool) {
+		found := make(chan PathResult)
+		done := make(chan struct{})
+		go func() {
+			defer close(found)
+			emit := func(result PathResult) bool {
+				select {
+				case found <- result:
+					return true
+				case <-done:
+					return false
+				case <-ctx.Done():
+					return false
+				}
+			}
+			rows, cols := ps.matrix.GetDimensions()
+			for startX := range rows {
+				for startY := range cols {
+					select {
+					case <-done:
+						return
+					case <-ctx.Done():
+						return
+					default:
+					}
+					ps.searchFromPosition(startX, startY, emit)
+				}
+			}
+		}()
+		// Aguarda o fim da busca para não deixar goroutines para trás
+		defer func() {
+			close(done)
+			for range found {
+			}
+		}()
+
+		for result := range found {
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// SearchAllWords percorre todas as células como ponto de partida
+func (ps *PathSearcher) SearchAllWords() []PathResult {
+	results, _ := ps.SearchAllWordsContext(context.Background())
+	return results
+}
+
+// SearchAllWordsContext é SearchAllWords interrompível entre pontos de partida pelo contexto
+func (ps *PathSearcher) SearchAllWordsContext(ctx context.Context) ([]PathResult, error) {
+	rows, cols := ps.matrix.GetDimensions()
+	results := make([]PathResult, 0, 128)
+	for startX := range rows {
+		for startY := range cols {
+			if err := ctx.Err(); err != nil {
+				return results, err
+			}
+			results = append(results, ps.SearchFromPosition(startX, startY)...)
+		}
+	}
+	return results, nil
+}
+
+func (pc *pathCollector) add(result PathResult) {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+	key := result.String()
+	if _, ok := pc.found[key]; ok {
+		return
+	}
+	pc.found[key] = result
+	if pc.emit != nil && !pc.emit(result) {
+		pc.stopped.Store(true)
+	}
+}
+
+func toWalk(word Word, limitGoroutines chan struct{}) {
+	//word.PrintBreadCrumb()
+	var wg sync.WaitGroup
+	rows, cols := word.matrix.GetDimensions()
+	var buffer [8]Coord
+	for _, next := range word.adjacency.Neighbors(buffer[:0], word.coordinates[len(word.coordinates)-1], rows, cols) {
+		newWord := word.clone()
+		if newWord.canStep(next) {
+			limitGoroutines <- struct{}{}
+			wg.Go(func() {
+				toWalk(newWord, limitGoroutines)
+				<-limitGoroutines
+			})
+			wg.Wait()
+		}
+	}
+}
+
+// clone copia a palavra para que cada ramo da busca estenda seu próprio caminho
+func (w Word) clone() Word {
+	newWord := Word{
+		word:          make([]rune, len(w.word)),
+		coordinates:   make([]Coord, len(w.coordinates)),
+		matrix:        w.matrix,
+		dictionary:    w.dictionary,
+		adjacency:     w.adjacency,
+		remaining:     w.remaining,
+		staticLetters: w.staticLetters,
+		collector:     w.collector,
+	}
+	copy(newWord.word, w.word)
+	copy(newWord.coordinates, w.coordinates)
+	return newWord
+}
+
+// canStep estende o caminho até a célula e registra a palavra se houver; false se nenhuma
+// palavra mais longa puder ser completada com as letras ainda livres na matriz
+func (w *Word) canStep(newCoord Coord) bool {
+	if w.collector.stopped.Load() || w.hasVisitedCell(newCoord) {
+		return false
+	}
+
+	cell := w.matrix.GetMatrix()[newCoord.X][newCoord.Y]
+	if !isLetter(cell) {
+		return false
+	}
+	w.word = append(w.word, cell)
+	w.coordinates = append(w.coordinates, newCoord)
+	if !w.staticLetters {
+		w.remaining.take(cell)
+	}
+	stringWord := strings.ToUpper(string(w.word))
+	node := w.dictionary.lookup(stringWord)
+	if node == nil {
+		return false
+	}
+	if node.isWord {
+		coordinates := make([]Coord, len(w.coordinates))
+		copy(coordinates, w.coordinates)
+		w.collector.add(PathResult{Word: stringWord, Coordinates: coordinates})
+	}
+	return node.canExtend(w.remaining.set)
+}
+
+// hasVisitedCell checks if a coordinate was already visited by walking backwards through the path
+func (w *Word) hasVisitedCell(coord Coord) bool {
+	// Walk backwards through the coordinates to check for repeated visits
+	for i := len(w.coordinates) - 1; i >= 0; i-- {
+		if w.coordinates[i].X == coord.X && w.coordinates[i].Y == coord.Y {
+			return true
+		}
+	}
+	return false
+}
+
+// sortPathResults ordena por pontuação e, em empate, pelas palavras mais longas
+func sortPathResults(results []PathResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score.Total != results[j].Score.Total {
+			return results[i].Score.Total > results[j].Score.Total
+		}
+		if len(results[i].Word) != len(results[j].Word) {
+			return len(results[i].Word) > len(results[j].Word)
+		}
+		return results[i].String() < results[j].String()
+	})
+}
+
+// Solve entrega as palavras da matriz à medida que são encontradas, com o buscador indicado
+// pelo jogo da matriz (linhas retas no caça-palavras, caminhos livres nos demais)
+func Solve(ctx context.Context, matrix *LetterMatrix, dictionary *Dictionary, scorer Scorer) iter.Seq[PathResult] {
+	if matrix.GetMeta().Game != GameWordSearch {
+		searcher := NewPathSearcher(matrix, dictionary)
+		searcher.SetScorer(scorer)
+		return searcher.Stream(ctx)
+	}
+
+	searcher := NewWordSimpleSearcher(matrix, dictionary)
+	searcher.SetScorer(scorer)
+	return func(yield func(PathResult) bool) {
+		for result := range searcher.Stream(ctx) {
+			if !yield(PathResult{Word: result.Word, Coordinates: result.Path, Score: result.Score}) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+// TestPathSearcher tests the adjacency walk and result scoring
+func TestPathSearcher(t *testing.T) {
+	dictFile := createTempFile(t, "test_dict_path_*.txt", "PLANET\nPLANETS\nPLATEN")
+	defer dictFile.Close()
+	defer os.Remove(dictFile.Name())
+
+	dict, err := NewDictionary(dictFile.Name())
+	if err != nil {
+		t.Fatalf("Failed to load test dictionary: %v", err)
+	}
+
+	// P L A
+	// T E N
+	matrix, err := NewLetterMatrixFromString("plA\nten")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+
+	searcher := NewPathSearcher(matrix, dict)
+	results := searcher.SearchAllWords()
+
+	found := make(map[string]PathResult)
+	for _, result := range results {
+		found[result.String()] = result
+	}
+
+	planet, ok := found["PLANET (1,1)(1,2)(1,3)(2,3)(2,2)(2,1)"]
+	if !ok {
+		t.Fatalf("Expected PLANET path, got %v", results)
+	}
+	if planet.Score.Total != 106 {
+		t.Errorf("Expected PLANET to score 106, got %s", planet.Score)
+	}
+	if _, ok := found["PLATEN (1,1)(1,2)(1,3)(2,1)(2,2)(2,3)"]; ok {
+		t.Error("PLATEN path is not adjacent and must not be found")
+	}
+
+	sortPathResults(results)
+	if results[0].Score.Total < results[len(results)-1].Score.Total {
+		t.Error("Expected results sorted by score")
+	}
+}
+
+// TestPathSearcherStream tests that streaming yields the same results and stops early on break
+func TestPathSearcherStream(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLANETS", "PLATEN", "PLEATS")
+	matrix, err := NewLetterMatrixFromString("plan\nstex")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	searcher := NewPathSearcher(matrix, dict)
+
+	expected := make(map[string]int)
+	for _, result := range searcher.SearchAllWords() {
+		expected[result.String()] = result.Score.Total
+	}
+	streamed := 0
+	for result := range searcher.Stream(context.Background()) {
+		streamed++
+		if score, ok := expected[result.String()]; !ok || score != result.Score.Total {
+			t.Errorf("Unexpected streamed result %s [%d]", result, result.Score.Total)
+		}
+	}
+	if streamed != len(expected) {
+		t.Errorf("Expected %d streamed results, got %d", len(expected), streamed)
+	}
+
+	count := 0
+	for range searcher.Stream(context.Background()) {
+		if count++; count == 1 {
+			break
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected the loop to stop after one result, got %d", count)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for result := range searcher.Stream(ctx) {
+		t.Errorf("Cancelled stream yielded %s", result)
+	}
+}
+
+// TestSolveStreamWordSearch tests that word search boards stream straight lines only
+func TestSolveStreamWordSearch(t *testing.T) {
+	dict := loadTestDictionary(t, "STREAM", "MASTER")
+	matrix, err := parseBoard([]string{"---", "game: wordsearch", "---", "maerts", "xxxxxx"})
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+	scorer, _ := NewScorer(DEFAULT_RULES)
+
+	var words []string
+	for result := range Solve(context.Background(), matrix, dict, scorer) {
+		words = append(words, result.Word+" "+result.Path())
+	}
+	if len(words) != 1 || words[0] != "STREAM (1,6)(1,5)(1,4)(1,3)(1,2)(1,1)" {
+		t.Errorf("Expected STREAM read backwards, got %v", words)
+	}
+}
+
+// TestSolveMaskedBoard tests that neither engine walks or reads through masked cells
+func TestSolveMaskedBoard(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	scorer, _ := NewScorer(DEFAULT_RULES)
+
+	for _, game := range []string{GameFree, GameWordSearch} {
+		matrix, err := parseBoard([]string{"---", "game: " + game, "---", "pla#net", "#######"})
+		if err != nil {
+			t.Fatalf("Failed to parse board: %v", err)
+		}
+		for result := range Solve(context.Background(), matrix, dict, scorer) {
+			t.Errorf("%s: expected no words through the mask, got %s", game, result)
+		}
+	}
+
+	// On a diamond the walker still finds words through the unmasked cells
+	matrix, _ := NewLetterMatrixFromGrid("#pl#/tena/#xx#")
+	found := NewPathSearcher(matrix, dict).SearchAllWords()
+	if len(found) != 1 || found[0].Path() != "(1,2)(1,3)(2,4)(2,3)(2,2)(2,1)" {
+		t.Errorf("Expected PLANET around the masked corners, got %v", found)
+	}
+}
+
+// BenchmarkLetterCounts solves each example board pruning only by the letters of the board
+// (full) and by the letters still free after the path (counted)
+func BenchmarkLetterCounts(b *testing.B) {
+	dict, err := NewDictionary(DEFAULT_DICTIONARY)
+	if err != nil {
+		b.Fatalf("Failed to load dictionary: %v", err)
+	}
+	for _, board := range []string{"res/example.txt", "res/example_easy.txt", "res/example_tower.txt", "res/example_search.txt"} {
+		matrix, err := NewLetterMatrixFromFile(board)
+		if err != nil {
+			b.Fatalf("Failed to load %s: %v", board, err)
+		}
+		for _, static := range []bool{true, false} {
+			name := board + "/counted"
+			if static {
+				name = board + "/full"
+			}
+			b.Run(name, func(b *testing.B) {
+				for b.Loop() {
+					if board == "res/example_search.txt" {
+						lines := NewWordSimpleSearcher(matrix, dict)
+						lines.staticLetters = static
+						lines.SearchAllWords(4)
+						continue
+					}
+					paths := NewPathSearcher(matrix, dict)
+					paths.staticLetters = static
+					paths.SearchAllWords()
+				}
+			})
+		}
+	}
+}
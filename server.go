@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRequest = errors.New("requisição inválida")
+
+// Limites do servidor HTTP
+const (
+	DEFAULT_SERVE_ADDR    = ":8080"
+	DEFAULT_SERVE_TIMEOUT = 10 * time.Second
+	MAX_REQUEST_BYTES     = 1 << 20
+)
+
+// Server expõe o buscador como API JSON; o dicionário é carregado uma única vez
+type Server struct {
+	dictionary *Dictionary
+	timeout    time.Duration
+	mux        *http.ServeMux
+}
+
+// SolveRequest é o corpo de POST /solve. Board aceita qualquer formato de matriz suportado;
+// Grid é a alternativa com uma linha por elemento.
+type SolveRequest struct {
+	Board string   `json:"board,omitempty"`
+	Grid  []string `json:"grid,omitempty"`
+	Rules string   `json:"rules,omitempty"`
+	Game  string   `json:"game,omitempty"`
+	// Adjacency sobrescreve a vizinhança do cabeçalho (orthogonal, king, hex, knight, torus; sufixo +wrap)
+	Adjacency string `json:"adjacency,omitempty"`
+	// Bends permite curvas na busca em linha reta, ex: "1@90" (sobrescreve o cabeçalho)
+	Bends string `json:"bends,omitempty"`
+	// Limit limita o número de palavras retornadas (0 retorna todas)
+	Limit int `json:"limit,omitempty"`
+	// Aggregate agrupa os caminhos por palavra: all, unique, best ou specials (ignorado com Stream)
+	Aggregate string `json:"aggregate,omitempty"`
+	// Stream responde em NDJSON, uma palavra por linha assim que é encontrada
+	Stream bool `json:"stream,omitempty"`
+}
+
+// SolvedWord é uma palavra encontrada, com o caminho em coordenadas de base 1
+type SolvedWord struct {
+	Word      string      `json:"word"`
+	Path      [][2]int    `json:"path"`
+	Score     int         `json:"score"`
+	Breakdown []ScoreItem `json:"breakdown,omitempty"`
+	// Paths é o número de caminhos que formam a palavra, quando há agregação
+	Paths int `json:"paths,omitempty"`
+}
+
+// SolveResponse é a resposta de POST /solve
+type SolveResponse struct {
+	Rows      int          `json:"rows"`
+	Cols      int          `json:"cols"`
+	Rules     string       `json:"rules"`
+	Total     int          `json:"total"`
+	Words     []SolvedWord `json:"words"`
+	ElapsedMs int64        `json:"elapsed_ms"`
+}
+
+// AnagramRequest é o corpo de POST /anagram
+type AnagramRequest struct {
+	Letters string `json:"letters"`
+	Exact   bool   `json:"exact,omitempty"`
+}
+
+// AnagramResponse é a resposta de POST /anagram
+type AnagramResponse struct {
+	Letters string   `json:"letters"`
+	Words   []string `json:"words"`
+}
+
+// WordResponse é a resposta de GET /dict/word/{word}
+type WordResponse struct {
+	Word   string `json:"word"`
+	Valid  bool   `json:"valid"`
+	Prefix bool   `json:"prefix"`
+	// Completions é quantas palavras do dicionário começam com Word, incluindo ela mesma
+	Completions int `json:"completions"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewServer cria o servidor com o tempo limite aplicado a cada requisição
+func NewServer(dictionary *Dictionary, timeout time.Duration) *Server {
+	s := &Server{dictionary: dictionary, timeout: timeout, mux: http.NewServeMux()}
+	s.mux.HandleFunc("POST /solve", s.handleSolve)
+	s.mux.HandleFunc("POST /anagram", s.handleAnagram)
+	s.mux.HandleFunc("GET /dict/word/{word}", s.handleWord)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleSolve(w http.ResponseWriter, r *http.Request) {
+	var request SolveRequest
+	if !decodeRequest(w, r, &request) {
+		return
+	}
+	matrix, err := request.matrix()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rules := request.Rules
+	if rules == "" {
+		rules = matrix.GetMeta().Rules
+	}
+	if rules == "" {
+		rules = DEFAULT_RULES
+	}
+	scorer, err := NewScorer(rules)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	aggregate := AggregateAll
+	if request.Aggregate != "" {
+		if aggregate, err = ParseAggregateMode(request.Aggregate); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+	if request.Stream {
+		streamSolve(ctx, w, matrix, s.dictionary, scorer, request.Limit)
+		return
+	}
+	start := time.Now()
+	found, err := searchBoardContext(ctx, matrix, s.dictionary, scorer)
+	if err != nil {
+		writeError(w, http.StatusGatewayTimeout, err)
+		return
+	}
+	aggregated := Aggregate(found, aggregate, matrix)
+
+	rows, cols := matrix.GetDimensions()
+	response := SolveResponse{Rows: rows, Cols: cols, Rules: scorer.Name(), Total: len(aggregated)}
+	if request.Limit > 0 && len(aggregated) > request.Limit {
+		aggregated = aggregated[:request.Limit]
+	}
+	response.Words = make([]SolvedWord, len(aggregated))
+	for i, result := range aggregated {
+		response.Words[i] = newSolvedWord(result.PathResult)
+		if request.Aggregate != "" {
+			response.Words[i].Paths = result.Paths
+		}
+	}
+	response.ElapsedMs = time.Since(start).Milliseconds()
+	writeJSON(w, http.StatusOK, response)
+}
+
+// streamSolve escreve cada palavra como uma linha JSON assim que é encontrada. Como o status
+// já foi enviado, um tempo limite esgotado é informado numa última linha {"error": ...}.
+func streamSolve(ctx context.Context, w http.ResponseWriter, matrix *LetterMatrix, dictionary *Dictionary, scorer Scorer, limit int) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+
+	sent := 0
+	for result := range Solve(ctx, matrix, dictionary, scorer) {
+		if err := encoder.Encode(newSolvedWord(result)); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if sent++; limit > 0 && sent == limit {
+			return
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		encoder.Encode(errorResponse{Error: err.Error()})
+	}
+}
+
+func newSolvedWord(result PathResult) SolvedWord {
+	path := make([][2]int, len(result.Coordinates))
+	for j, coord := range result.Coordinates {
+		path[j] = [2]int{coord.X + 1, coord.Y + 1}
+	}
+	return SolvedWord{Word: result.Word, Path: path, Score: result.Score.Total, Breakdown: result.Score.Breakdown}
+}
+
+// matrix monta a matriz da requisição; Game, Bends e Adjacency sobrescrevem o cabeçalho
+func (request SolveRequest) matrix() (*LetterMatrix, error) {
+	var matrix *LetterMatrix
+	var err error
+	switch {
+	case request.Board != "" && request.Grid != nil:
+		return nil, fmt.Errorf("%w: informe board ou grid, não ambos", ErrInvalidRequest)
+	case request.Board != "":
+		matrix, err = parseBoard(strings.Split(request.Board, "\n"))
+	case request.Grid != nil:
+		matrix, err = parsePlainBoard(request.Grid, BoardMeta{})
+	default:
+		return nil, fmt.Errorf("%w: matriz ausente", ErrInvalidRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if request.Game != "" {
+		meta := matrix.GetMeta()
+		meta.Game = strings.ToLower(request.Game)
+		if err := matrix.SetMeta(meta); err != nil {
+			return nil, err
+		}
+	}
+	if request.Bends != "" {
+		bends, err := ParseBends(request.Bends)
+		if err != nil {
+			return nil, err
+		}
+		matrix.SetBends(bends)
+	}
+	if request.Adjacency != "" {
+		adjacency, err := ParseAdjacency(request.Adjacency)
+		if err != nil {
+			return nil, err
+		}
+		if err := matrix.SetAdjacency(adjacency); err != nil {
+			return nil, err
+		}
+	}
+	return matrix, nil
+}
+
+// searchBoardContext é searchBoard interrompível pelo contexto
+func searchBoardContext(ctx context.Context, matrix *LetterMatrix, dictionary *Dictionary, scorer Scorer) ([]PathResult, error) {
+	if matrix.GetMeta().Game != GameWordSearch {
+		searcher := NewPathSearcher(matrix, dictionary)
+		searcher.SetScorer(scorer)
+		return searcher.SearchAllWordsContext(ctx)
+	}
+
+	searcher := NewWordSimpleSearcher(matrix, dictionary)
+	searcher.SetScorer(scorer)
+	// Um worker por matriz: o lote já processa as matrizes em paralelo, e o servidor as requisições
+	err := searcher.SearchAllWordsContext(ctx, 1)
+	results := searcher.GetResults()
+	found := make([]PathResult, len(results))
+	for i, result := range results {
+		found[i] = PathResult{Word: result.Word, Coordinates: result.Path, Score: result.Score}
+	}
+	return found, err
+}
+
+func (s *Server) handleAnagram(w http.ResponseWriter, r *http.Request) {
+	var request AnagramRequest
+	if !decodeRequest(w, r, &request) {
+		return
+	}
+	if strings.TrimSpace(request.Letters) == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("%w: letras ausentes", ErrInvalidRequest))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+	words, err := s.dictionary.Anagrams(ctx, request.Letters, request.Exact)
+	if err != nil {
+		writeError(w, http.StatusGatewayTimeout, err)
+		return
+	}
+	if words == nil {
+		words = []string{}
+	}
+	writeJSON(w, http.StatusOK, AnagramResponse{Letters: strings.ToUpper(request.Letters), Words: words})
+}
+
+func (s *Server) handleWord(w http.ResponseWriter, r *http.Request) {
+	word := strings.ToUpper(r.PathValue("word"))
+	writeJSON(w, http.StatusOK, WordResponse{
+		Word:        word,
+		Valid:       s.dictionary.IsWord(word),
+		Prefix:      s.dictionary.IsPrefix(word),
+		Completions: s.dictionary.CountPrefix(word),
+	})
+}
+
+// decodeRequest lê o corpo JSON (limitado a MAX_REQUEST_BYTES) e responde 400 em caso de erro
+func decodeRequest(w http.ResponseWriter, r *http.Request, target any) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, MAX_REQUEST_BYTES))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %w", ErrInvalidRequest, err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+// runServe implementa o subcomando "wordgo serve"
+func runServe(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := flags.String("addr", DEFAULT_SERVE_ADDR, "Endereço de escuta")
+	dictFile := flags.String("dict", DEFAULT_DICTIONARY, "Arquivo de dicionário")
+	timeout := flags.Duration("timeout", DEFAULT_SERVE_TIMEOUT, "Tempo limite de cada requisição")
+	grpcAddr := flags.String("grpc", "", "Endereço de escuta do serviço gRPC (vazio desativa)")
+	flags.Parse(args)
+
+	dict, err := NewDictionary(*dictFile)
+	if err != nil {
+		log.Fatalf("Erro ao carregar dicionário: %v", err)
+	}
+	dict.PrintDictionaryStats()
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           NewServer(dict, *timeout),
+		ReadHeaderTimeout: 5 * time.Second,
+		WriteTimeout:      *timeout + 5*time.Second,
+	}
+	if *grpcAddr != "" {
+		go func() {
+			fmt.Printf("Servindo gRPC em %s\n", *grpcAddr)
+			log.Fatal(serveGRPC(*grpcAddr, NewGRPCServer(dict, *timeout)))
+		}()
+	}
+	fmt.Printf("Servindo em %s\n", *addr)
+	log.Fatal(server.ListenAndServe())
+}
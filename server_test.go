@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T, timeout time.Duration) *httptest.Server {
+	t.Helper()
+	dict := loadTestDictionary(t, "PLANET", "PLANETS", "STREAM", "MASTER")
+	server := httptest.NewServer(NewServer(dict, timeout))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func postJSON(t *testing.T, url, body string, target any) int {
+	t.Helper()
+	response, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST %s failed: %v", url, err)
+	}
+	defer response.Body.Close()
+	if err := json.NewDecoder(response.Body).Decode(target); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	return response.StatusCode
+}
+
+// TestServerSolve tests POST /solve with grids, headers and options
+func TestServerSolve(t *testing.T) {
+	server := newTestServer(t, time.Second)
+
+	var response SolveResponse
+	status := postJSON(t, server.URL+"/solve", `{"grid": ["plan", "stex"], "rules": "boggle"}`, &response)
+	if status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+	if response.Rows != 2 || response.Cols != 4 || response.Rules != "boggle" {
+		t.Errorf("Unexpected response header: %+v", response)
+	}
+	if response.Total != 2 || response.Words[0].Word != "PLANETS" {
+		t.Fatalf("Expected PLANETS and PLANET, got %+v", response.Words)
+	}
+	expectedPath := [][2]int{{1, 1}, {1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 2}, {2, 1}}
+	if len(response.Words[0].Path) != len(expectedPath) {
+		t.Fatalf("Unexpected path %v", response.Words[0].Path)
+	}
+	for i := range expectedPath {
+		if response.Words[0].Path[i] != expectedPath[i] {
+			t.Errorf("Unexpected path %v", response.Words[0].Path)
+			break
+		}
+	}
+
+	// Header board limited to one result, searched in straight lines only
+	response = SolveResponse{}
+	board := `{"board": "---\ngame: wordsearch\n---\nmaster\nxxxxxx", "limit": 1}`
+	if status := postJSON(t, server.URL+"/solve", board, &response); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+	if response.Total != 1 || len(response.Words) != 1 || response.Words[0].Word != "MASTER" {
+		t.Errorf("Unexpected word search response: %+v", response)
+	}
+}
+
+// TestServerErrors tests the error responses of the API
+func TestServerErrors(t *testing.T) {
+	server := newTestServer(t, time.Second)
+
+	testCases := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+	}{
+		{"missing board", "/solve", `{}`, http.StatusBadRequest},
+		{"both board and grid", "/solve", `{"board": "abc", "grid": ["abc"]}`, http.StatusBadRequest},
+		{"unknown rules", "/solve", `{"grid": ["abc"], "rules": "chess"}`, http.StatusBadRequest},
+		{"unknown aggregate", "/solve", `{"grid": ["abc"], "aggregate": "first"}`, http.StatusBadRequest},
+		{"unknown field", "/solve", `{"grid": ["abc"], "colour": "red"}`, http.StatusBadRequest},
+		{"malformed json", "/anagram", `{"letters":`, http.StatusBadRequest},
+		{"missing letters", "/anagram", `{"letters": " "}`, http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		var response errorResponse
+		if status := postJSON(t, server.URL+tc.path, tc.body, &response); status != tc.status {
+			t.Errorf("%s: expected %d, got %d", tc.name, tc.status, status)
+		}
+		if response.Error == "" {
+			t.Errorf("%s: expected an error message", tc.name)
+		}
+	}
+
+	response, err := http.Get(server.URL + "/solve")
+	if err != nil {
+		t.Fatalf("GET /solve failed: %v", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405 for GET /solve, got %d", response.StatusCode)
+	}
+}
+
+// TestServerTimeout tests that an expired request context is reported as a gateway timeout
+func TestServerTimeout(t *testing.T) {
+	server := newTestServer(t, time.Nanosecond)
+
+	var response errorResponse
+	if status := postJSON(t, server.URL+"/solve", `{"grid": ["plan", "stex"]}`, &response); status != http.StatusGatewayTimeout {
+		t.Errorf("Expected 504, got %d (%s)", status, response.Error)
+	}
+}
+
+// TestServerAnagramAndWord tests POST /anagram and GET /dict/word/{word}
+func TestServerAnagramAndWord(t *testing.T) {
+	server := newTestServer(t, time.Second)
+
+	var anagrams AnagramResponse
+	if status := postJSON(t, server.URL+"/anagram", `{"letters": "tsamer?"}`, &anagrams); status != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", status)
+	}
+	if strings.Join(anagrams.Words, ",") != "MASTER,STREAM" {
+		t.Errorf("Unexpected anagrams %v", anagrams.Words)
+	}
+
+	var exact AnagramResponse
+	postJSON(t, server.URL+"/anagram", `{"letters": "tsamer?", "exact": true}`, &exact)
+	if len(exact.Words) != 0 {
+		t.Errorf("Exact anagrams must use every letter, got %v", exact.Words)
+	}
+
+	response, err := http.Get(server.URL + "/dict/word/planet")
+	if err != nil {
+		t.Fatalf("GET /dict/word failed: %v", err)
+	}
+	defer response.Body.Close()
+	var word WordResponse
+	if err := json.NewDecoder(response.Body).Decode(&word); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if word != (WordResponse{Word: "PLANET", Valid: true, Prefix: true, Completions: 2}) {
+		t.Errorf("Unexpected word response %+v", word)
+	}
+}
+
+// TestDictionaryAnagramsCancelled tests that Anagrams stops on a cancelled context
+func TestDictionaryAnagramsCancelled(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "STREAM")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// The check runs every 1024 nodes, so a tiny trie may finish anyway
+	if _, err := dict.Anagrams(ctx, strings.Repeat("?", 8), false); err != nil && !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// TestServerSolveStream tests the NDJSON streaming response of POST /solve
+func TestServerSolveStream(t *testing.T) {
+	server := newTestServer(t, time.Second)
+
+	response, err := http.Post(server.URL+"/solve", "application/json", strings.NewReader(`{"grid": ["plan", "stex"], "stream": true}`))
+	if err != nil {
+		t.Fatalf("POST /solve failed: %v", err)
+	}
+	defer response.Body.Close()
+	if contentType := response.Header.Get("Content-Type"); contentType != "application/x-ndjson" {
+		t.Errorf("Expected NDJSON, got %q", contentType)
+	}
+
+	var words []string
+	decoder := json.NewDecoder(response.Body)
+	for decoder.More() {
+		var word SolvedWord
+		if err := decoder.Decode(&word); err != nil {
+			t.Fatalf("Failed to decode line: %v", err)
+		}
+		words = append(words, word.Word)
+	}
+	if strings.Join(words, ",") != "PLANET,PLANETS" {
+		t.Errorf("Expected PLANET then PLANETS, got %v", words)
+	}
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"iter"
 	"runtime/debug"
 	"strings"
 	"sync"
+	"unicode"
 )
 
 // WordSearcher representa o sistema de busca de palavras
@@ -13,70 +16,147 @@ type WordSearcher struct {
 	matrix     *LetterMatrix
 	dictionary *Dictionary
 	directions []Direction
-	results    []WordResult
-	seen       map[string]bool // Para evitar duplicatas
-	mutex      sync.Mutex
+	bends      BendOptions
+	turns      [][]int // Por índice de directions, as direções para as quais se pode virar
+	letters    letterBag
+	// staticLetters poda só pelas letras da matriz, sem descontar as já usadas na linha;
+	// existe para os benchmarks medirem o ganho da contagem
+	staticLetters bool
+	scorer        Scorer
+	results       []WordResult
+	seen          map[string]bool // Para evitar duplicatas
+	mutex         sync.Mutex
+}
+
+// directionBetween retorna a direção que leva de uma célula à vizinha
+func directionBetween(from, to Coord) (Direction, bool) {
+	for _, direction := range lineDirections {
+		if from.X+direction.DeltaRow == to.X && from.Y+direction.DeltaCol == to.Y {
+			return direction, true
+		}
+	}
+	return Direction{}, false
 }
 
 // NewWordSimpleSearcher cria um novo buscador de palavras
 func NewWordSimpleSearcher(matrix *LetterMatrix, dictionary *Dictionary) *WordSearcher {
-	return &WordSearcher{
+	scorer, _ := NewScorer(DEFAULT_RULES)
+	ws := &WordSearcher{
 		matrix:     matrix,
 		dictionary: dictionary,
-		directions: []Direction{
-			{R, "→", 0, 1},
-			{L, "←", 0, -1},
-			{B, "↓", 1, 0},
-			{T, "↑", -1, 0},
-			{BR, "↘", 1, 1},
-			{BL, "↙", 1, -1},
-			{TR, "↗", -1, 1},
-			{TL, "↖", -1, -1},
-		},
-		results: make([]WordResult, 0),
-		seen:    make(map[string]bool),
+		directions: matrix.GetAdjacency().Lines(),
+		bends:      matrix.GetBends(),
+		letters:    matrix.LetterBag(),
+		scorer:     scorer,
+		results:    make([]WordResult, 0),
+		seen:       make(map[string]bool),
 	}
+	if ws.bends.MaxTurns > 0 {
+		ws.turns = ws.bends.turnTable(ws.directions, isHex(matrix.GetAdjacency()))
+	}
+	return ws
+}
+
+// SetScorer define as regras de pontuação aplicadas aos resultados
+func (ws *WordSearcher) SetScorer(scorer Scorer) {
+	ws.scorer = scorer
 }
 
 // SearchFromPosition busca palavras a partir de uma posição específica em uma direção
 func (ws *WordSearcher) SimpleSearchFromPosition(startRow, startCol int, direction Direction) {
+	ws.scanLine(context.Background(), startRow, startCol, direction, func(result WordResult) bool {
+		ws.addResult(result)
+		return true
+	})
+}
+
+// scanLine entrega cada palavra encontrada na linha; retorna false se emit interromper a busca.
+// A linha reta é curta e não olha o contexto; com curvas, o número de caminhos cresce
+// exponencialmente e o contexto é verificado ao longo da busca.
+func (ws *WordSearcher) scanLine(ctx context.Context, startRow, startCol int, direction Direction, emit func(WordResult) bool) bool {
+	if ws.bends.MaxTurns > 0 {
+		for dirIndex := range ws.directions {
+			if ws.directions[dirIndex] == direction {
+				scan := &bentScan{ws: ws, ctx: ctx, start: Coord{X: startRow, Y: startCol}, remaining: ws.letters, segments: []string{direction.Name}, emit: emit}
+				return scan.scanBent(scan.start, dirIndex, 0)
+			}
+		}
+	}
+
 	matrix := ws.matrix.GetMatrix()
 	rows, cols := ws.matrix.GetDimensions()
 
+	adjacency := ws.matrix.GetAdjacency()
+
 	var currentWord strings.Builder
-	row, col := startRow, startCol
+	path := make([]Coord, 0, max(rows, cols))
+	remaining := ws.letters
+	cell, ok := Coord{X: startRow, Y: startCol}, startRow >= 0 && startRow < rows && startCol >= 0 && startCol < cols
 
 	// Buscar na direção especificada
-	for row >= 0 && row < rows && col >= 0 && col < cols {
-		char := matrix[row][col]
+	for ok {
+		char := matrix[cell.X][cell.Y]
 
-		// Parar se encontrar espaço
-		if char == ' ' {
+		// Parar se encontrar espaço ou célula bloqueada
+		if !isLetter(char) {
 			break
 		}
 
-		currentWord.WriteRune(char)
+		currentWord.WriteRune(unicode.ToUpper(char))
 		sequence := currentWord.String()
+		path = append(path, cell)
+		if !ws.staticLetters {
+			remaining.take(char)
+		}
 
 		// Verificar se é um prefixo válido
-		if !ws.dictionary.IsPrefix(sequence) {
+		node := ws.dictionary.lookup(sequence)
+		if node == nil {
 			break // Não há palavras que começam com esta sequência
 		}
 
 		// Verificar se é uma palavra válida (mínimo 3 caracteres)
-		if len(sequence) >= 3 && ws.dictionary.IsWord(sequence) {
-			ws.addResult(WordResult{
+		if len(sequence) >= 3 && node.isWord {
+			result := WordResult{
 				Word:      sequence,
 				StartRow:  startRow,
 				StartCol:  startCol,
 				Direction: direction.Name,
 				Length:    len(sequence),
-			})
+				Path:      append([]Coord(nil), path...),
+				Score:     ws.scorer.Score(sequence, path, ws.matrix),
+			}
+			if !emit(result) {
+				return false
+			}
 		}
 
-		// Mover para a próxima posição na direção
-		row += direction.DeltaRow
-		col += direction.DeltaCol
+		// Nenhuma palavra mais longa pode ser completada com as letras ainda livres
+		if !node.canExtend(remaining.set) {
+			break
+		}
+
+		// Mover para a próxima posição na direção; com wrap a linha termina antes de repetir uma célula
+		cell, ok = adjacency.Step(cell, direction, rows, cols)
+		ok = ok && !containsCoord(path, cell)
+	}
+	return true
+}
+
+// Stream percorre as linhas em sequência e entrega cada palavra assim que é encontrada,
+// sem acumular em GetResults. Sair do laço ou cancelar o contexto interrompe a busca.
+func (ws *WordSearcher) Stream(ctx context.Context) iter.Seq[WordResult] {
+	return func(yield func(WordResult) bool) {
+		rows, cols := ws.matrix.GetDimensions()
+		for row := 0; row < rows; row++ {
+			for col := 0; col < cols; col++ {
+				for _, direction := range ws.directions {
+					if ctx.Err() != nil || !ws.scanLine(ctx, row, col, direction, yield) {
+						return
+					}
+				}
+			}
+		}
 	}
 }
 
@@ -85,8 +165,8 @@ func (ws *WordSearcher) addResult(result WordResult) {
 	ws.mutex.Lock()
 	defer ws.mutex.Unlock()
 
-	// Criar chave única para evitar duplicatas
-	key := fmt.Sprintf("%s_%d_%d_%s", result.Word, result.StartRow, result.StartCol, result.Direction)
+	// Criar chave única para evitar duplicatas; com curvas, início e direção não bastam
+	key := fmt.Sprintf("%s_%v", result.Word, result.Path)
 
 	// Só adicionar se não vimos esta combinação antes
 	if !ws.seen[key] {
@@ -97,7 +177,17 @@ func (ws *WordSearcher) addResult(result WordResult) {
 
 // SearchAllWords busca todas as palavras na matriz usando goroutines
 func (ws *WordSearcher) SearchAllWords(numWorkers int) {
+	ws.SearchAllWordsContext(context.Background(), numWorkers)
+}
+
+// SearchAllWordsContext é SearchAllWords interrompível pelo contexto, inclusive no meio
+// de uma busca com curvas; os resultados encontrados até ali ficam em GetResults
+func (ws *WordSearcher) SearchAllWordsContext(ctx context.Context, numWorkers int) error {
 	rows, cols := ws.matrix.GetDimensions()
+	emit := func(result WordResult) bool {
+		ws.addResult(result)
+		return true
+	}
 
 	// Canal para distribuir trabalho
 	jobs := make(chan [3]int, rows*cols*len(ws.directions))
@@ -109,8 +199,12 @@ func (ws *WordSearcher) SearchAllWords(numWorkers int) {
 		go func() {
 			defer wg.Done()
 			for job := range jobs {
+				// Depois do cancelamento os trabalhos restantes só são descartados
+				if ctx.Err() != nil {
+					continue
+				}
 				startRow, startCol, dirIndex := job[0], job[1], job[2]
-				ws.SimpleSearchFromPosition(startRow, startCol, ws.directions[dirIndex])
+				ws.scanLine(ctx, startRow, startCol, ws.directions[dirIndex], emit)
 			}
 		}()
 	}
@@ -127,6 +221,7 @@ func (ws *WordSearcher) SearchAllWords(numWorkers int) {
 
 	// Aguardar todos os workers terminarem
 	wg.Wait()
+	return ctx.Err()
 }
 
 // GetResults retorna todos os resultados encontrados
@@ -151,8 +246,8 @@ func (ws *WordSearcher) PrintResults() {
 	for direction, words := range byDirection {
 		fmt.Printf("%s (%d palavras):\n", direction, len(words))
 		for _, word := range words {
-			fmt.Printf("  '%s' em (%d,%d) - %d letras\n",
-				word.Word, word.StartRow, word.StartCol, word.Length)
+			fmt.Printf("  '%s' em (%d,%d) - %d letras - %s pontos\n",
+				word.Word, word.StartRow, word.StartCol, word.Length, word.Score)
 		}
 		fmt.Println()
 	}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package main
+
+import "os"
+
+// enableRawMode não é suportado fora do Linux: as teclas exigem enter
+func enableRawMode(file *os.File) (func(), error) {
+	return func() {}, nil
+}
+
+// terminalSize retorna o tamanho padrão 80x24
+func terminalSize(file *os.File) (int, int) {
+	return 80, 24
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+)
+
+var ErrUnknownTopBy = errors.New("critério de top-K desconhecido")
+
+// TopKCriterion define o que torna uma palavra melhor no modo top-K
+type TopKCriterion int
+
+const (
+	// TopByLength prefere as palavras mais longas (empate pela pontuação)
+	TopByLength TopKCriterion = iota
+	// TopByScore prefere as palavras de maior pontuação (empate pelo comprimento)
+	TopByScore
+)
+
+// DEFAULT_TOP_BY é o critério padrão da flag -top-by
+const DEFAULT_TOP_BY = "length"
+
+// ParseTopKCriterion converte o nome da flag -top-by
+func ParseTopKCriterion(name string) (TopKCriterion, error) {
+	switch strings.ToLower(name) {
+	case "length":
+		return TopByLength, nil
+	case "score":
+		return TopByScore, nil
+	}
+	return 0, fmt.Errorf("%w: %q (disponíveis: length, score)", ErrUnknownTopBy, name)
+}
+
+// topEntry é o melhor caminho conhecido de uma palavra no heap
+type topEntry struct {
+	result PathResult
+	value  int
+	// tie desempata valores iguais: a pontuação no critério length e o comprimento no critério score
+	tie   int
+	path  string
+	index int
+}
+
+// worse ordena as entradas de forma total, para que o resultado não dependa da ordem de descoberta
+func (e *topEntry) worse(other *topEntry) bool {
+	if e.value != other.value {
+		return e.value < other.value
+	}
+	if e.tie != other.tie {
+		return e.tie < other.tie
+	}
+	if e.result.Word != other.result.Word {
+		return e.result.Word > other.result.Word
+	}
+	return e.path > other.path
+}
+
+// topHeap é um heap de mínimo: a raiz é a pior das K melhores palavras
+type topHeap []*topEntry
+
+func (h topHeap) Len() int           { return len(h) }
+func (h topHeap) Less(i, j int) bool { return h[i].worse(h[j]) }
+func (h topHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+func (h *topHeap) Push(x any) {
+	entry := x.(*topEntry)
+	entry.index = len(*h)
+	*h = append(*h, entry)
+}
+func (h *topHeap) Pop() any {
+	old := *h
+	entry := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return entry
+}
+
+// topCollector mantém as K melhores palavras distintas; threshold é o valor da K-ésima (MinInt64 enquanto não há K)
+type topCollector struct {
+	k         int
+	mutex     sync.Mutex
+	heap      topHeap
+	byWord    map[string]*topEntry
+	threshold atomic.Int64
+}
+
+func newTopCollector(k int) *topCollector {
+	tc := &topCollector{k: k, byWord: make(map[string]*topEntry)}
+	tc.threshold.Store(math.MinInt64)
+	return tc
+}
+
+// canBeat indica se um ramo com o limite informado ainda pode entrar no top-K.
+// Empates não são podados, para que o desempate seja o mesmo em qualquer ordem de busca.
+func (tc *topCollector) canBeat(bound int) bool {
+	threshold := tc.threshold.Load()
+	return int64(bound) >= threshold
+}
+
+func (tc *topCollector) offer(result PathResult, value, tie int) {
+	entry := &topEntry{result: result, value: value, tie: tie, path: result.Path()}
+
+	tc.mutex.Lock()
+	defer tc.mutex.Unlock()
+	if current, ok := tc.byWord[result.Word]; ok {
+		if current.worse(entry) {
+			current.result, current.value, current.tie, current.path = entry.result, entry.value, entry.tie, entry.path
+			heap.Fix(&tc.heap, current.index)
+		}
+	} else if len(tc.heap) < tc.k {
+		heap.Push(&tc.heap, entry)
+		tc.byWord[result.Word] = entry
+	} else if tc.heap[0].worse(entry) {
+		delete(tc.byWord, tc.heap[0].result.Word)
+		tc.heap[0] = entry
+		entry.index = 0
+		heap.Fix(&tc.heap, 0)
+		tc.byWord[result.Word] = entry
+	}
+	if len(tc.heap) == tc.k {
+		tc.threshold.Store(int64(tc.heap[0].value))
+	}
+}
+
+// results retorna as palavras da melhor para a pior
+func (tc *topCollector) results() []PathResult {
+	entries := append(topHeap(nil), tc.heap...)
+	results := make([]PathResult, len(entries))
+	for i := len(results) - 1; i >= 0; i-- {
+		results[i] = heap.Pop(&entries).(*topEntry).result
+	}
+	return results
+}
+
+// TopKResults seleciona as k melhores palavras distintas de resultados já encontrados
+func TopKResults(results []PathResult, k int, by TopKCriterion) []PathResult {
+	if k <= 0 {
+		return nil
+	}
+	collector := newTopCollector(k)
+	for _, result := range results {
+		value, tie := topValue(result, by)
+		collector.offer(result, value, tie)
+	}
+	return collector.results()
+}
+
+// topSearch é o caminhamento com poda de um ponto de partida
+type topSearch struct {
+	searcher  *PathSearcher
+	collector *topCollector
+	by        TopKCriterion
+	// bounds[n] é o maior valor possível para uma palavra de até n letras
+	bounds  []int
+	letters int
+	// remaining são as letras da matriz fora do caminho, para descartar ramos que exigem letras já usadas
+	remaining letterBag
+	visited   [][]bool
+	path      []Coord
+	word      []rune
+	ctx       context.Context
+	steps     int
+	// cancelled fica ligado depois que o contexto é cancelado, encerrando todo o caminhamento
+	cancelled bool
+}
+
+// TopK retorna as k melhores palavras distintas pelo critério, podando os ramos cujo maior
+// comprimento (pela profundidade da trie e pelas células restantes) ou pontuação possível
+// não alcança a k-ésima melhor. Sem limite de pontuação nas regras, só o comprimento poda.
+func (ps *PathSearcher) TopK(ctx context.Context, k int, by TopKCriterion) ([]PathResult, error) {
+	if k <= 0 {
+		return nil, nil
+	}
+	rows, cols := ps.matrix.GetDimensions()
+	letters := ps.matrix.CountLetters()
+	remaining := ps.matrix.LetterBag()
+	bounds := make([]int, letters+1)
+	for n := range bounds {
+		bounds[n] = n
+		if by == TopByScore {
+			bounds[n] = math.MaxInt
+			if bounder, ok := ps.scorer.(ScoreBounder); ok {
+				if bound, ok := bounder.MaxScore(n, ps.matrix); ok {
+					bounds[n] = bound
+				}
+			}
+		}
+	}
+
+	collector := newTopCollector(k)
+	starts := make(chan Coord)
+	var wg sync.WaitGroup
+	for range runtime.GOMAXPROCS(0) {
+		wg.Go(func() {
+			search := &topSearch{
+				searcher:  ps,
+				collector: collector,
+				by:        by,
+				bounds:    bounds,
+				letters:   letters,
+				remaining: remaining,
+				visited:   newBoolGrid(rows, cols),
+				ctx:       ctx,
+			}
+			for start := range starts {
+				search.walk(start, ps.dictionary.trie)
+			}
+		})
+	}
+	for row := range rows {
+		for col := range cols {
+			if ctx.Err() != nil {
+				break
+			}
+			starts <- Coord{X: row, Y: col}
+		}
+	}
+	close(starts)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return collector.results(), nil
+}
+
+func (ts *topSearch) walk(cell Coord, node *TrieNode) {
+	if ts.cancelled {
+		return
+	}
+	if ts.steps++; ts.steps%1024 == 0 && ts.ctx.Err() != nil {
+		ts.cancelled = true
+		return
+	}
+	matrix := ts.searcher.matrix
+	char := matrix.GetMatrix()[cell.X][cell.Y]
+	if !isLetter(char) || ts.visited[cell.X][cell.Y] {
+		return
+	}
+	child := node.children[unicode.ToUpper(char)]
+	if child == nil {
+		return
+	}
+
+	ts.visited[cell.X][cell.Y] = true
+	ts.path = append(ts.path, cell)
+	ts.word = append(ts.word, unicode.ToUpper(char))
+	if !ts.searcher.staticLetters {
+		ts.remaining.take(char)
+	}
+
+	// Maior comprimento alcançável: limitado pela trie e pelas letras ainda livres
+	reach := len(ts.path) + min(child.maxDepth, ts.letters-len(ts.path))
+	if ts.collector.canBeat(ts.bounds[reach]) {
+		if child.isWord {
+			ts.offer()
+		}
+		if child.canExtend(ts.remaining.set) {
+			rows, cols := matrix.GetDimensions()
+			var buffer [8]Coord
+			for _, next := range matrix.GetAdjacency().Neighbors(buffer[:0], cell, rows, cols) {
+				ts.walk(next, child)
+			}
+		}
+	}
+
+	ts.visited[cell.X][cell.Y] = false
+	if !ts.searcher.staticLetters {
+		ts.remaining.put(char)
+	}
+	ts.path = ts.path[:len(ts.path)-1]
+	ts.word = ts.word[:len(ts.word)-1]
+}
+
+func (ts *topSearch) offer() {
+	word := string(ts.word)
+	path := append([]Coord(nil), ts.path...)
+	result := PathResult{Word: word, Coordinates: path, Score: ts.searcher.scorer.Score(word, path, ts.searcher.matrix)}
+	value, tie := topValue(result, ts.by)
+	if ts.collector.canBeat(value) {
+		ts.collector.offer(result, value, tie)
+	}
+}
+
+// topValue retorna o valor do critério e o desempate de um resultado
+func topValue(result PathResult, by TopKCriterion) (value, tie int) {
+	if by == TopByScore {
+		return result.Score.Total, len([]rune(result.Word))
+	}
+	return len([]rune(result.Word)), result.Score.Total
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParseTopKCriterion tests the -top-by names
+func TestParseTopKCriterion(t *testing.T) {
+	if by, err := ParseTopKCriterion("SCORE"); err != nil || by != TopByScore {
+		t.Errorf("Expected TopByScore, got %v, %v", by, err)
+	}
+	if by, err := ParseTopKCriterion(DEFAULT_TOP_BY); err != nil || by != TopByLength {
+		t.Errorf("Expected TopByLength, got %v, %v", by, err)
+	}
+	if _, err := ParseTopKCriterion("width"); !errors.Is(err, ErrUnknownTopBy) {
+		t.Errorf("Expected ErrUnknownTopBy, got %v", err)
+	}
+}
+
+// TestTopKMatchesFullSearch tests that the pruned search returns the same words as ranking every result
+func TestTopKMatchesFullSearch(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLANETS", "PLATEN", "PLANTS", "STAPLE", "PALEST", "PASTEL", "PETALS")
+	matrix, err := parseBoard([]string{"---", "multiplier: 2,3 2W", "---", "plAx", "tenS", "xtSe"})
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+
+	for _, rules := range RuleSetNames() {
+		scorer, _ := NewScorer(rules)
+		searcher := NewPathSearcher(matrix, dict)
+		searcher.SetScorer(scorer)
+		all := searcher.SearchAllWords()
+
+		for _, by := range []TopKCriterion{TopByLength, TopByScore} {
+			for k := 1; k <= 4; k++ {
+				got, err := searcher.TopK(context.Background(), k, by)
+				if err != nil {
+					t.Fatalf("%s: unexpected error %v", rules, err)
+				}
+				expected := TopKResults(all, k, by)
+				if !slices.EqualFunc(got, expected, func(a, b PathResult) bool { return a.String() == b.String() }) {
+					t.Errorf("%s by %d, k=%d: expected %v, got %v", rules, by, k, expected, got)
+				}
+			}
+		}
+	}
+}
+
+// TestTopKDistinctWords tests that each word appears once with its best path
+func TestTopKDistinctWords(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLANETS")
+	matrix, err := NewLetterMatrixFromGrid("planet/xxxxxS")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	searcher := NewPathSearcher(matrix, dict)
+
+	got, err := searcher.TopK(context.Background(), 5, TopByLength)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if len(got) != 2 || got[0].Word != "PLANETS" || got[1].Word != "PLANET" {
+		t.Errorf("Expected PLANETS then PLANET, got %v", got)
+	}
+}
+
+// TestTopKCancelled tests that a cancelled context stops the search
+func TestTopKCancelled(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	matrix, _ := NewLetterMatrixFromGrid("planet")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewPathSearcher(matrix, dict).TopK(ctx, 1, TopByLength); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// TestMaxScore tests that the rule set bounds are never below an actual score
+func TestMaxScore(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLANETS", "PLATEN", "STAPLE", "PASTEL")
+	matrix, err := parseBoard([]string{"---", "multiplier: 1,2 3L", "multiplier: 2,3 2W", "---", "plAx", "tenS", "xtSe"})
+	if err != nil {
+		t.Fatalf("Failed to parse board: %v", err)
+	}
+
+	for _, rules := range RuleSetNames() {
+		scorer, _ := NewScorer(rules)
+		bounder, ok := scorer.(ScoreBounder)
+		if !ok {
+			continue
+		}
+		searcher := NewPathSearcher(matrix, dict)
+		searcher.SetScorer(scorer)
+		for _, result := range searcher.SearchAllWords() {
+			bound, ok := bounder.MaxScore(len(result.Word), matrix)
+			if ok && bound < result.Score.Total {
+				t.Errorf("%s: bound %d below %s scoring %d", rules, bound, result.Word, result.Score.Total)
+			}
+		}
+	}
+}
+
+// TestTopKCancelledDuringSearch tests that cancelling stops a search that is already walking
+func TestTopKCancelledDuringSearch(t *testing.T) {
+	// Every self-avoiding path is a prefix of the long word, so the walk would take far too long to finish
+	dict := loadTestDictionary(t, strings.Repeat("A", 30))
+	matrix, err := NewLetterMatrixFromGrid(strings.Repeat("aaaaaa/", 5) + "aaaaaa")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := NewPathSearcher(matrix, dict).TopK(ctx, 1, TopByLength); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected the search to stop soon after the deadline, took %s", elapsed)
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrBoardTooLarge     = errors.New("matriz grande demais para análise exaustiva")
+	ErrRefillUnsupported = errors.New("a análise exaustiva não aceita gravidade com reposição")
+	ErrClearBudget       = errors.New("limite de estados da análise exaustiva atingido")
+)
+
+// MAX_CLEAR_LETTERS limita a busca exaustiva de CanClear
+const MAX_CLEAR_LETTERS = 36
+
+// MAX_CLEAR_STATES limita quantos estados distintos CanClear avalia antes de desistir
+const MAX_CLEAR_STATES = 5000
+
+// TowerAnalysis resume o estado de uma matriz do modo torre
+type TowerAnalysis struct {
+	Letters int
+	// Words são os caminhos disponíveis na matriz atual
+	Words []PathResult
+	// UsableNow marca as células que fazem parte de algum caminho atual
+	UsableNow [][]bool
+	// Reachable marca as células cuja letra aparece em alguma palavra formável
+	// com o multiconjunto de letras da matriz (condição necessária para uso futuro)
+	Reachable [][]bool
+	// Stranded são as células que nunca poderão ser usadas
+	Stranded []Coord
+}
+
+// ClearResult é o resultado da busca exaustiva por uma sequência que esvazia a matriz
+type ClearResult struct {
+	CanClear bool
+	// MinRemaining é o menor número de letras que sobram ao fim de qualquer sequência
+	MinRemaining int
+	// Moves é uma sequência que atinge MinRemaining
+	Moves []PathResult
+	// States é o número de estados distintos avaliados
+	States int
+}
+
+// AnalyzeTower calcula quais células podem ser usadas agora e quais ficarão presas
+func AnalyzeTower(matrix *LetterMatrix, dict *Dictionary) *TowerAnalysis {
+	rows, cols := matrix.GetDimensions()
+	analysis := &TowerAnalysis{
+		Letters:   matrix.CountLetters(),
+		Words:     NewPathSearcher(matrix, dict).SearchAllWords(),
+		UsableNow: newBoolGrid(rows, cols),
+		Reachable: newBoolGrid(rows, cols),
+	}
+
+	for _, result := range analysis.Words {
+		for _, coord := range result.Coordinates {
+			analysis.UsableNow[coord.X][coord.Y] = true
+		}
+	}
+
+	usableLetters := dict.lettersFormableFrom(letterCounts(matrix))
+	for i, row := range matrix.GetMatrix() {
+		for j, cell := range row {
+			if !isLetter(cell) {
+				continue
+			}
+			analysis.Reachable[i][j] = analysis.UsableNow[i][j] || usableLetters[unicode.ToUpper(cell)]
+			if !analysis.Reachable[i][j] {
+				analysis.Stranded = append(analysis.Stranded, Coord{X: i, Y: j})
+			}
+		}
+	}
+	return analysis
+}
+
+// PrintTowerAnalysis imprime a análise da matriz
+func (ta *TowerAnalysis) PrintTowerAnalysis(matrix *LetterMatrix) {
+	usable := 0
+	for _, row := range ta.UsableNow {
+		for _, cell := range row {
+			if cell {
+				usable++
+			}
+		}
+	}
+	fmt.Printf("Letras na matriz: %d\n", ta.Letters)
+	fmt.Printf("Caminhos disponíveis: %d\n", len(ta.Words))
+	fmt.Printf("Células utilizáveis agora: %d\n", usable)
+	fmt.Printf("Células presas: %d\n", len(ta.Stranded))
+
+	for i, row := range matrix.GetMatrix() {
+		var sb strings.Builder
+		for j, cell := range row {
+			switch {
+			case !isLetter(cell):
+				sb.WriteRune(cell)
+			case !ta.Reachable[i][j]:
+				sb.WriteRune('x')
+			case ta.UsableNow[i][j]:
+				sb.WriteRune('*')
+			default:
+				sb.WriteRune('.')
+			}
+		}
+		fmt.Printf("%2d: %s   %s\n", i, string(row), sb.String())
+	}
+}
+
+// CanClear procura, com memoização, a sequência de palavras que deixa o menor número de letras.
+// Retorna ErrBoardTooLarge se a matriz tiver mais de MAX_CLEAR_LETTERS letras, ErrRefillUnsupported
+// se a gravidade repuser letras (a matriz nunca esvaziaria), ErrClearBudget após MAX_CLEAR_STATES
+// estados e o erro do contexto se ele for cancelado durante a busca.
+func CanClear(ctx context.Context, matrix *LetterMatrix, dict *Dictionary) (ClearResult, error) {
+	return canClear(ctx, matrix, dict, MAX_CLEAR_STATES)
+}
+
+func canClear(ctx context.Context, matrix *LetterMatrix, dict *Dictionary, budget int) (ClearResult, error) {
+	letters := matrix.CountLetters()
+	if letters > MAX_CLEAR_LETTERS {
+		return ClearResult{}, fmt.Errorf("%w: %d letras (máximo %d)", ErrBoardTooLarge, letters, MAX_CLEAR_LETTERS)
+	}
+	if matrix.GetGravity().Refill {
+		return ClearResult{}, ErrRefillUnsupported
+	}
+
+	solver := &clearSolver{ctx: ctx, dict: dict, memo: make(map[string]clearStep), budget: budget}
+	remaining := solver.solve(matrix.Clone())
+	if solver.err != nil {
+		return ClearResult{}, solver.err
+	}
+
+	result := ClearResult{CanClear: remaining == 0, MinRemaining: remaining, States: len(solver.memo)}
+	state := matrix.Clone()
+	for {
+		step := solver.memo[state.String()]
+		if step.move == nil {
+			break
+		}
+		result.Moves = append(result.Moves, *step.move)
+		state.RemoveLetters(step.move.Coordinates)
+	}
+	return result, nil
+}
+
+type clearStep struct {
+	remaining int
+	move      *PathResult
+}
+
+type clearSolver struct {
+	ctx    context.Context
+	dict   *Dictionary
+	memo   map[string]clearStep
+	budget int
+	// err interrompe a recursão inteira assim que o contexto ou o limite de estados é atingido
+	err error
+}
+
+func (cs *clearSolver) solve(matrix *LetterMatrix) int {
+	if cs.err != nil {
+		return 0
+	}
+	key := matrix.String()
+	if step, ok := cs.memo[key]; ok {
+		return step.remaining
+	}
+	if err := cs.ctx.Err(); err != nil {
+		cs.err = err
+		return 0
+	}
+	if len(cs.memo) >= cs.budget {
+		cs.err = fmt.Errorf("%w: %d estados", ErrClearBudget, cs.budget)
+		return 0
+	}
+
+	best := clearStep{remaining: matrix.CountLetters()}
+	cs.memo[key] = best
+	if best.remaining == 0 {
+		return 0
+	}
+
+	for _, move := range distinctMoves(NewPathSearcher(matrix, cs.dict).SearchAllWords()) {
+		next := matrix.Clone()
+		next.RemoveLetters(move.Coordinates)
+		remaining := cs.solve(next)
+		if cs.err != nil {
+			return 0
+		}
+		if remaining < best.remaining {
+			best = clearStep{remaining: remaining, move: &move}
+			if remaining == 0 {
+				break
+			}
+		}
+	}
+	cs.memo[key] = best
+	return best.remaining
+}
+
+// distinctMoves descarta caminhos que removem exatamente o mesmo conjunto de células
+func distinctMoves(results []PathResult) []PathResult {
+	sortPathResults(results)
+	seen := make(map[string]bool, len(results))
+	moves := make([]PathResult, 0, len(results))
+	for _, result := range results {
+		cells := slices.Clone(result.Coordinates)
+		slices.SortFunc(cells, func(a, b Coord) int {
+			if a.X != b.X {
+				return a.X - b.X
+			}
+			return a.Y - b.Y
+		})
+		key := PathResult{Coordinates: cells}.Path()
+		if !seen[key] {
+			seen[key] = true
+			moves = append(moves, result)
+		}
+	}
+	return moves
+}
+
+// lettersFormableFrom retorna as letras que aparecem em alguma palavra formável com as letras disponíveis
+func (d *Dictionary) lettersFormableFrom(available map[rune]int) map[rune]bool {
+	usable := make(map[rune]bool)
+	counts := make(map[rune]int)
+	for word := range d.words {
+		clear(counts)
+		fits := true
+		for _, char := range word {
+			counts[char]++
+			if counts[char] > available[char] {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			for char := range counts {
+				usable[char] = true
+			}
+		}
+	}
+	return usable
+}
+
+// letterCounts conta as letras da matriz em maiúsculas
+func letterCounts(matrix *LetterMatrix) map[rune]int {
+	counts := make(map[rune]int)
+	for _, row := range matrix.GetMatrix() {
+		for _, cell := range row {
+			if isLetter(cell) {
+				counts[unicode.ToUpper(cell)]++
+			}
+		}
+	}
+	return counts
+}
+
+func newBoolGrid(rows, cols int) [][]bool {
+	grid := make([][]bool, rows)
+	for i := range grid {
+		grid[i] = make([]bool, cols)
+	}
+	return grid
+}
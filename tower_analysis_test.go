@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func loadTestDictionary(t *testing.T, words ...string) *Dictionary {
+	t.Helper()
+	dictFile := createTempFile(t, "test_dict_*.txt", strings.Join(words, "\n"))
+	dictFile.Close()
+	t.Cleanup(func() { os.Remove(dictFile.Name()) })
+
+	dict, err := NewDictionary(dictFile.Name())
+	if err != nil {
+		t.Fatalf("Failed to load test dictionary: %v", err)
+	}
+	return dict
+}
+
+// TestAnalyzeTowerStranded tests that letters outside every formable word are reported
+func TestAnalyzeTowerStranded(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLATEN")
+	matrix, err := NewLetterMatrixFromString("qpla\nxten")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+
+	analysis := AnalyzeTower(matrix, dict)
+	if analysis.Letters != 8 {
+		t.Errorf("Expected 8 letters, got %d", analysis.Letters)
+	}
+	if len(analysis.Words) == 0 {
+		t.Fatal("Expected PLANET to be available")
+	}
+	if len(analysis.Stranded) != 2 || analysis.Stranded[0] != (Coord{0, 0}) || analysis.Stranded[1] != (Coord{1, 0}) {
+		t.Errorf("Expected q and x stranded, got %v", analysis.Stranded)
+	}
+	if !analysis.UsableNow[0][1] || analysis.UsableNow[0][0] {
+		t.Errorf("Unexpected usable cells %v", analysis.UsableNow)
+	}
+}
+
+// TestCanClear tests the exhaustive clearing search
+func TestCanClear(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "STREAM")
+
+	// STREAM is only adjacent once PLANET has been removed and the letters fell
+	matrix, err := NewLetterMatrixFromString("str\npla\nten\nmae")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+
+	result, err := CanClear(context.Background(), matrix, dict)
+	if err != nil {
+		t.Fatalf("CanClear failed: %v", err)
+	}
+	if !result.CanClear || result.MinRemaining != 0 {
+		t.Fatalf("Expected board to be clearable, got %+v", result)
+	}
+	if len(result.Moves) != 2 || result.Moves[0].Word != "PLANET" || result.Moves[1].Word != "STREAM" {
+		t.Errorf("Expected PLANET then STREAM, got %v", result.Moves)
+	}
+
+	stuck, err := NewLetterMatrixFromString("pla\ntez")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	result, err = CanClear(context.Background(), stuck, dict)
+	if err != nil {
+		t.Fatalf("CanClear failed: %v", err)
+	}
+	if result.CanClear || result.MinRemaining != 6 || len(result.Moves) != 0 {
+		t.Errorf("Expected board without moves, got %+v", result)
+	}
+}
+
+// TestCanClearTooLarge tests the exhaustive search size guard
+func TestCanClearTooLarge(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET")
+	matrix, err := NewLetterMatrixFromString(strings.Repeat("abcdefgh\n", 5) + "abcdefgh")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	if _, err := CanClear(context.Background(), matrix, dict); !errors.Is(err, ErrBoardTooLarge) {
+		t.Errorf("Expected ErrBoardTooLarge, got %v", err)
+	}
+}
+
+// TestCanClearBounded tests that refill gravity is rejected and that the budget and context stop the search
+func TestCanClearBounded(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "STREAM")
+	matrix, err := NewLetterMatrixFromString("str\npla\nten\nmae")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+
+	if _, err := canClear(context.Background(), matrix, dict, 1); !errors.Is(err, ErrClearBudget) {
+		t.Errorf("Expected ErrClearBudget, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := CanClear(ctx, matrix, dict); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	gravity, err := ParseGravity("down+refill", 1)
+	if err != nil {
+		t.Fatalf("Failed to parse gravity: %v", err)
+	}
+	matrix.SetGravity(gravity)
+	if _, err := CanClear(context.Background(), matrix, dict); !errors.Is(err, ErrRefillUnsupported) {
+		t.Errorf("Expected ErrRefillUnsupported, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"unicode"
+)
+
+// letterSet é um conjunto de letras em bits: A a Z ocupam os bits 0 a 25 e qualquer
+// outra letra cai no bit OTHER_LETTERS, o que mantém a poda conservadora
+type letterSet uint32
+
+// OTHER_LETTERS é o bit que representa as letras fora de A-Z (acentuadas, por exemplo)
+const OTHER_LETTERS letterSet = 1 << 26
+
+func letterBit(char rune) letterSet {
+	return 1 << letterIndex(char)
+}
+
+// letterIndex retorna a posição da letra no conjunto: 0 a 25 para A-Z, 26 para as demais
+func letterIndex(char rune) int {
+	char = unicode.ToUpper(char)
+	if char < 'A' || char > 'Z' {
+		return 26
+	}
+	return int(char - 'A')
+}
+
+// contains indica se todas as letras de other estão no conjunto
+func (ls letterSet) contains(other letterSet) bool {
+	return ls&other == other
+}
+
+// annotate calcula, de baixo para cima, os metadados de cada nó depois da carga do dicionário
+func (node *TrieNode) annotate() {
+	node.maxDepth, node.letters, node.words = 0, 0, 0
+	if node.isWord {
+		node.words = 1
+	}
+	// Um nó que já é palavra não exige nenhuma letra a mais
+	required, first := letterSet(0), !node.isWord
+	for char, child := range node.children {
+		child.annotate()
+		bit := letterBit(char)
+		node.maxDepth = max(node.maxDepth, child.maxDepth+1)
+		node.letters |= bit | child.letters
+		node.words += child.words
+		if first {
+			required, first = bit|child.required, false
+		} else {
+			required &= bit | child.required
+		}
+	}
+	node.required = required
+}
+
+// canExtend indica se alguma palavra abaixo do nó pode ser completada com as letras
+// disponíveis: todas as letras obrigatórias precisam existir e ao menos uma das alcançáveis
+func (node *TrieNode) canExtend(available letterSet) bool {
+	return len(node.children) > 0 && available.contains(node.required) && node.letters&available != 0
+}
+
+// lookup retorna o nó da sequência, ou nil se ela não for prefixo de nenhuma palavra
+func (d *Dictionary) lookup(sequence string) *TrieNode {
+	node := d.trie
+	for _, char := range sequence {
+		if node = node.children[char]; node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// CountPrefix retorna quantas palavras do dicionário começam com a sequência
+func (d *Dictionary) CountPrefix(sequence string) int {
+	if node := d.lookup(sequence); node != nil {
+		return node.words
+	}
+	return 0
+}
+
+// letterBag é o multiconjunto das letras ainda livres na matriz. A busca retira a letra de
+// cada célula que entra no caminho, e a letra só sai de set quando a última cópia é usada:
+// um ramo que exige um segundo E numa matriz com um só E é podado sem consultar um sub-dicionário.
+type letterBag struct {
+	counts [27]int32
+	set    letterSet
+}
+
+// take retira uma cópia da letra do multiconjunto
+func (bag *letterBag) take(char rune) {
+	index := letterIndex(char)
+	if bag.counts[index]--; bag.counts[index] == 0 {
+		bag.set &^= 1 << index
+	}
+}
+
+// put devolve uma cópia da letra ao multiconjunto
+func (bag *letterBag) put(char rune) {
+	index := letterIndex(char)
+	bag.counts[index]++
+	bag.set |= 1 << index
+}
+
+// LetterBag retorna o multiconjunto das letras presentes na matriz
+func (lm *LetterMatrix) LetterBag() letterBag {
+	var bag letterBag
+	for _, row := range lm.matrix {
+		for _, cell := range row {
+			if isLetter(cell) {
+				bag.put(cell)
+			}
+		}
+	}
+	return bag
+}
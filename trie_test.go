@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// setOf builds a letter set from a string for the expectations
+func setOf(letters string) letterSet {
+	var set letterSet
+	for _, char := range letters {
+		set |= letterBit(char)
+	}
+	return set
+}
+
+// TestTrieAnnotations tests the subtree metadata computed at load
+func TestTrieAnnotations(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLANETS", "PLANTS", "STREAM")
+
+	testCases := []struct {
+		prefix   string
+		maxDepth int
+		letters  letterSet
+		required letterSet
+		words    int
+	}{
+		{"", 7, setOf("PLANETSRM"), setOf("AT"), 4},
+		{"PLAN", 3, setOf("ETS"), setOf("T"), 3},
+		{"PLANET", 1, setOf("S"), 0, 2},
+		{"PLANT", 1, setOf("S"), setOf("S"), 1},
+		{"STREAM", 0, 0, 0, 1},
+	}
+	for _, tc := range testCases {
+		node := dict.lookup(tc.prefix)
+		if node == nil {
+			t.Fatalf("%q: expected a trie node", tc.prefix)
+		}
+		if node.maxDepth != tc.maxDepth || node.letters != tc.letters || node.required != tc.required || node.words != tc.words {
+			t.Errorf("%q: expected depth %d, letters %b, required %b, words %d; got %d, %b, %b, %d",
+				tc.prefix, tc.maxDepth, tc.letters, tc.required, tc.words,
+				node.maxDepth, node.letters, node.required, node.words)
+		}
+	}
+
+	if dict.lookup("PLANS") != nil || dict.CountPrefix("PLANS") != 0 {
+		t.Error("Expected PLANS not to be a prefix")
+	}
+	if got := dict.CountPrefix("PLAN"); got != 3 {
+		t.Errorf("Expected 3 words starting with PLAN, got %d", got)
+	}
+}
+
+// TestCanExtend tests the pruning by letters missing from the board
+func TestCanExtend(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLANETS", "PLANTS")
+	node := dict.lookup("PLAN")
+	if !node.canExtend(setOf("PLANET")) {
+		t.Error("PLAN can become PLANET with the letters of PLANET")
+	}
+	if node.canExtend(setOf("PLANS")) {
+		t.Error("Every word after PLAN needs a T")
+	}
+	if dict.lookup("PLANETS").canExtend(setOf("PLANETS")) {
+		t.Error("A leaf cannot be extended")
+	}
+	if letterBit('É') != OTHER_LETTERS || letterBit('e') != letterBit('E') {
+		t.Error("Expected letters outside A-Z to share the other letters bit")
+	}
+}
+
+// TestPruningKeepsResults tests that both engines find the same words with the pruning in place
+func TestPruningKeepsResults(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLANETS", "PLATEN", "PLANTS", "PLANTER")
+	matrix, err := NewLetterMatrixFromGrid("plan/xtex/xxxx")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+
+	var paths []string
+	for _, result := range NewPathSearcher(matrix, dict).SearchAllWords() {
+		paths = append(paths, result.Word)
+	}
+	slices.Sort(paths)
+	if !slices.Equal(slices.Compact(paths), []string{"PLANET", "PLATEN"}) {
+		t.Errorf("Expected PLANET and PLATEN, got %v", paths)
+	}
+
+	matrix, _ = NewLetterMatrixFromGrid("planets/xxxxxxx")
+	searcher := NewWordSimpleSearcher(matrix, dict)
+	searcher.SearchAllWords(1)
+	var lines []string
+	for _, result := range searcher.GetResults() {
+		lines = append(lines, result.Word)
+	}
+	slices.Sort(lines)
+	if !slices.Equal(lines, []string{"PLANET", "PLANETS"}) {
+		t.Errorf("Expected PLANET and PLANETS, got %v", lines)
+	}
+}
+
+// TestLetterBag tests that a letter leaves the set only when its last copy is taken
+func TestLetterBag(t *testing.T) {
+	matrix, err := NewLetterMatrixFromGrid("pEe/#é ")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	bag := matrix.LetterBag()
+	if bag.set != setOf("PEÉ") || bag.counts[letterIndex('E')] != 2 {
+		t.Fatalf("Expected P, two Es and another letter, got set %b and counts %v", bag.set, bag.counts)
+	}
+
+	bag.take('e')
+	if bag.set != setOf("PEÉ") {
+		t.Error("Expected E to stay while a copy is left")
+	}
+	bag.take('E')
+	if bag.set != setOf("PÉ") {
+		t.Errorf("Expected E to leave with its last copy, got %b", bag.set)
+	}
+	bag.put('e')
+	if bag.set != setOf("PEÉ") {
+		t.Errorf("Expected E back after put, got %b", bag.set)
+	}
+}
+
+// TestLetterCountsKeepResults tests that discounting the letters of the path finds the same words
+// as pruning by the letters of the board, in every engine
+func TestLetterCountsKeepResults(t *testing.T) {
+	dict := loadTestDictionary(t, "PLANET", "PLANETS", "PLATEN", "PLANTS", "PEPPER", "TEAPOT", "PALATE", "TENET")
+	matrix, err := NewLetterMatrixFromGrid("plAt/etpe/aSnp/tent")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	matrix.SetBends(BendOptions{MaxTurns: 1})
+
+	search := func(static bool) []string {
+		var found []string
+		paths := NewPathSearcher(matrix, dict)
+		paths.staticLetters = static
+		for _, result := range paths.SearchAllWords() {
+			found = append(found, result.String())
+		}
+		top, err := paths.TopK(context.Background(), 3, TopByLength)
+		if err != nil {
+			t.Fatalf("TopK failed: %v", err)
+		}
+		for _, result := range top {
+			found = append(found, "top "+result.String())
+		}
+		lines := NewWordSimpleSearcher(matrix, dict)
+		lines.staticLetters = static
+		lines.SearchAllWords(2)
+		for _, result := range lines.GetResults() {
+			found = append(found, fmt.Sprintf("%s %s %v", result.Word, result.Direction, result.Path))
+		}
+		slices.Sort(found)
+		return found
+	}
+
+	static, counted := search(true), search(false)
+	if len(static) == 0 || !slices.Equal(static, counted) {
+		t.Errorf("Expected the same results, got %v with the board letters and %v with the counts", static, counted)
+	}
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Sequências ANSI usadas pela interface
+const (
+	ansiReset        = "\x1b[0m"
+	ansiBold         = "\x1b[1m"
+	ansiDim          = "\x1b[2m"
+	ansiReverse      = "\x1b[7m"
+	ansiMagenta      = "\x1b[35m"
+	ansiPathStart    = "\x1b[1;30;42m"
+	ansiPathCell     = "\x1b[1;30;43m"
+	ansiClearScreen  = "\x1b[H\x1b[2J"
+	ansiAltScreenOn  = "\x1b[?1049h\x1b[?25l"
+	ansiAltScreenOff = "\x1b[?25h\x1b[?1049l"
+)
+
+// tuiKey representa uma tecla já decodificada
+type tuiKey int
+
+const (
+	keyNone tuiKey = iota
+	keyUp
+	keyDown
+	keyPageUp
+	keyPageDown
+	keyHome
+	keyEnd
+	keyEnter
+	keyQuit
+)
+
+// TUI é a interface de tela cheia para navegar nos resultados
+type TUI struct {
+	matrix   *LetterMatrix
+	results  []PathResult
+	selected int
+	offset   int
+	details  bool
+}
+
+// NewTUI cria a interface com os resultados já ordenados
+func NewTUI(matrix *LetterMatrix, results []PathResult) *TUI {
+	sortPathResults(results)
+	return &TUI{matrix: matrix, results: results}
+}
+
+// parseKey decodifica teclas simples e sequências de escape
+func parseKey(input []byte) tuiKey {
+	switch string(input) {
+	case "\x1b[A", "\x1bOA", "k", "K":
+		return keyUp
+	case "\x1b[B", "\x1bOB", "j", "J":
+		return keyDown
+	case "\x1b[5~", "p", "P":
+		return keyPageUp
+	case "\x1b[6~", "n", "N", " ":
+		return keyPageDown
+	case "\x1b[H", "\x1b[1~", "g":
+		return keyHome
+	case "\x1b[F", "\x1b[4~", "G":
+		return keyEnd
+	case "\r", "\n":
+		return keyEnter
+	case "q", "Q", "\x1b", "\x03":
+		return keyQuit
+	}
+	return keyNone
+}
+
+// HandleKey atualiza a seleção; retorna false quando o usuário pede para sair
+func (tui *TUI) HandleKey(key tuiKey, pageSize int) bool {
+	last := len(tui.results) - 1
+	switch key {
+	case keyQuit:
+		return false
+	case keyUp:
+		tui.selected--
+	case keyDown:
+		tui.selected++
+	case keyPageUp:
+		tui.selected -= pageSize
+	case keyPageDown:
+		tui.selected += pageSize
+	case keyHome:
+		tui.selected = 0
+	case keyEnd:
+		tui.selected = last
+	case keyEnter:
+		tui.details = !tui.details
+	}
+	tui.selected = max(0, min(tui.selected, last))
+	return true
+}
+
+// Selected retorna o resultado selecionado, se houver
+func (tui *TUI) Selected() (PathResult, bool) {
+	if len(tui.results) == 0 {
+		return PathResult{}, false
+	}
+	return tui.results[tui.selected], true
+}
+
+// Render desenha a matriz com o caminho destacado e a lista de resultados
+func (tui *TUI) Render(w io.Writer, height int) {
+	var sb strings.Builder
+	sb.WriteString(ansiClearScreen)
+	sb.WriteString(ansiBold + "WordGo" + ansiReset + fmt.Sprintf(" - %d resultados\r\n\r\n", len(tui.results)))
+
+	selected, hasSelection := tui.Selected()
+	lines := tui.renderBoard(selected.Coordinates)
+	for _, line := range lines {
+		sb.WriteString(line + "\r\n")
+	}
+	sb.WriteString("\r\n")
+
+	if hasSelection && tui.details {
+		fmt.Fprintf(&sb, "%s%s%s = %s\r\n\r\n", ansiBold, selected, ansiReset, selected.Score)
+	}
+
+	pageSize := tui.listSize(height)
+	if tui.selected < tui.offset {
+		tui.offset = tui.selected
+	}
+	if tui.selected >= tui.offset+pageSize {
+		tui.offset = tui.selected - pageSize + 1
+	}
+	for i := tui.offset; i < min(tui.offset+pageSize, len(tui.results)); i++ {
+		result := tui.results[i]
+		line := fmt.Sprintf("%4d. %-16s %5d  %s", i+1, result.Word, result.Score.Total, result.Path())
+		if i == tui.selected {
+			line = ansiReverse + line + ansiReset
+		}
+		sb.WriteString(line + "\r\n")
+	}
+	if len(tui.results) == 0 {
+		sb.WriteString("No words found...\r\n")
+	}
+
+	sb.WriteString(ansiDim + "\r\n↑/↓ j/k navegar  n/p página  g/G início/fim  enter detalhes  q sair" + ansiReset)
+	io.WriteString(w, sb.String())
+}
+
+// listSize calcula quantas linhas da lista cabem abaixo da matriz
+func (tui *TUI) listSize(height int) int {
+	rows, _ := tui.matrix.GetDimensions()
+	used := rows + 6
+	if tui.details {
+		used += 2
+	}
+	return max(height-used, 3)
+}
+
+// renderBoard desenha cada célula como letra seguida da seta para a próxima célula do caminho
+func (tui *TUI) renderBoard(path []Coord) []string {
+	order := make(map[Coord]int, len(path))
+	for i, coord := range path {
+		order[coord] = i + 1
+	}
+
+	lines := make([]string, 0, len(tui.matrix.GetMatrix()))
+	for i, row := range tui.matrix.GetMatrix() {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "%s%2d%s ", ansiDim, i+1, ansiReset)
+		for j, cell := range row {
+			coord := Coord{X: i, Y: j}
+			letter := string(unicode.ToUpper(cell))
+			arrow := " "
+			step := order[coord]
+			if step > 0 && step < len(path) {
+				if direction, ok := directionBetween(coord, path[step]); ok {
+					arrow = direction.Symbol
+				}
+			}
+
+			switch {
+			case step == 1:
+				sb.WriteString(ansiPathStart + letter + arrow + ansiReset)
+			case step > 1:
+				sb.WriteString(ansiPathCell + letter + arrow + ansiReset)
+			case cell == ' ':
+				sb.WriteString(ansiDim + "·" + arrow + ansiReset)
+			case cell == MASK_CELL:
+				sb.WriteString(" " + arrow)
+			case tui.matrix.IsSpecial(coord):
+				sb.WriteString(ansiMagenta + ansiBold + letter + ansiReset + arrow)
+			default:
+				sb.WriteString(letter + arrow)
+			}
+			sb.WriteString(" ")
+		}
+		lines = append(lines, sb.String())
+	}
+	return lines
+}
+
+// Run desenha a interface e processa o teclado até o usuário sair
+func (tui *TUI) Run(in io.Reader, out io.Writer, height int) {
+	reader := bufio.NewReader(in)
+	buffer := make([]byte, 8)
+	for {
+		tui.Render(out, height)
+		n, err := reader.Read(buffer)
+		if err != nil {
+			return
+		}
+		if !tui.HandleKey(parseKey(buffer[:n]), tui.listSize(height)) {
+			return
+		}
+	}
+}
+
+// runTUI implementa o subcomando "wordgo tui"
+func runTUI(args []string) {
+	flags := flag.NewFlagSet("tui", flag.ExitOnError)
+	matrixFile := flags.String("matrix", "res/example.txt", "Arquivo de matriz de letras para carregar")
+	dictFile := flags.String("dict", DEFAULT_DICTIONARY, "Arquivo de dicionário")
+	rules := flags.String("rules", DEFAULT_RULES, "Regras de pontuação: "+strings.Join(RuleSetNames(), ", "))
+	simple := flags.Bool("simple", false, "Usa a busca em linha reta em vez do caminhamento")
+	flags.Parse(args)
+
+	matrix, err := NewLetterMatrixFromFile(*matrixFile)
+	if err != nil {
+		log.Fatalf("Erro ao carregar matriz: %v", err)
+	}
+	dict, err := NewDictionary(*dictFile)
+	if err != nil {
+		log.Fatalf("Erro ao carregar dicionário: %v", err)
+	}
+	scorer, err := NewScorer(*rules)
+	if err != nil {
+		log.Fatalf("Erro ao selecionar regras: %v", err)
+	}
+
+	var results []PathResult
+	if *simple {
+		searcher := NewWordSimpleSearcher(matrix, dict)
+		searcher.SetScorer(scorer)
+		searcher.SearchAllWords(4)
+		for _, result := range searcher.GetResults() {
+			results = append(results, PathResult{Word: result.Word, Coordinates: result.Path, Score: result.Score})
+		}
+	} else {
+		searcher := NewPathSearcher(matrix, dict)
+		searcher.SetScorer(scorer)
+		results = searcher.SearchAllWords()
+	}
+
+	restore, err := enableRawMode(os.Stdin)
+	if err != nil {
+		log.Fatalf("Erro ao configurar o terminal: %v", err)
+	}
+	defer restore()
+
+	_, height := terminalSize(os.Stdout)
+	fmt.Print(ansiAltScreenOn)
+	defer fmt.Print(ansiAltScreenOff)
+	NewTUI(matrix, results).Run(os.Stdin, os.Stdout, height)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func newTestTUI(t *testing.T) *TUI {
+	t.Helper()
+	dict := loadTestDictionary(t, "PLANET", "PLATEN")
+	matrix, err := NewLetterMatrixFromString("plA\nten")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	return NewTUI(matrix, NewPathSearcher(matrix, dict).SearchAllWords())
+}
+
+// TestTUIRenderHighlightsPath tests that the selected path is coloured with direction arrows
+func TestTUIRenderHighlightsPath(t *testing.T) {
+	tui := newTestTUI(t)
+	selected, ok := tui.Selected()
+	if !ok || selected.Word != "PLANET" {
+		t.Fatalf("Expected PLANET selected, got %v", selected)
+	}
+
+	var out bytes.Buffer
+	tui.Render(&out, 24)
+	screen := out.String()
+
+	// PLANET: (1,1)→(1,2)→(1,3)↓(2,3)←(2,2)←(2,1)
+	for _, cell := range []string{ansiPathStart + "P→", ansiPathCell + "L→", ansiPathCell + "A↓", ansiPathCell + "N←", ansiPathCell + "E←", ansiPathCell + "T "} {
+		if !strings.Contains(screen, cell) {
+			t.Errorf("Expected rendered cell %q in screen:\n%q", cell, screen)
+		}
+	}
+	if !strings.Contains(screen, ansiReverse+"   1. PLANET") {
+		t.Errorf("Expected first result highlighted, got:\n%q", screen)
+	}
+}
+
+// TestTUIKeys tests key decoding and selection bounds
+func TestTUIKeys(t *testing.T) {
+	tui := newTestTUI(t)
+	keys := map[string]tuiKey{"\x1b[A": keyUp, "j": keyDown, "\x1b[6~": keyPageDown, "G": keyEnd, "q": keyQuit, "x": keyNone}
+	for input, expected := range keys {
+		if got := parseKey([]byte(input)); got != expected {
+			t.Errorf("parseKey(%q): expected %d, got %d", input, expected, got)
+		}
+	}
+
+	tui.HandleKey(keyUp, 5)
+	if tui.selected != 0 {
+		t.Errorf("Expected selection clamped at 0, got %d", tui.selected)
+	}
+	tui.HandleKey(keyPageDown, 5)
+	if tui.selected != len(tui.results)-1 {
+		t.Errorf("Expected selection clamped at last result, got %d", tui.selected)
+	}
+	if tui.HandleKey(keyQuit, 5) {
+		t.Error("Expected quit key to stop the loop")
+	}
+}
+
+// TestTUIRun tests the event loop with scripted keys
+func TestTUIRun(t *testing.T) {
+	tui := newTestTUI(t)
+	var out bytes.Buffer
+	tui.Run(strings.NewReader("\r"), &out, 24)
+	if !tui.details || !strings.Contains(out.String(), "PLANET (1,1)") {
+		t.Errorf("Expected details toggled, got:\n%q", out.String())
+	}
+}
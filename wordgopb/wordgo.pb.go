@@ -0,0 +1,628 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: wordgo.proto
+
+// Serviço gRPC do buscador; usa o mesmo motor da linha de comando.
+
+package wordgopb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Board é a matriz: text aceita qualquer formato suportado; grid tem uma linha por elemento
+type Board struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Grid          []string               `protobuf:"bytes,2,rep,name=grid,proto3" json:"grid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	mi := &file_wordgo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_wordgo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_wordgo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Board) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Board) GetGrid() []string {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+type SolveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Board *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Rules string                 `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules,omitempty"`
+	Game  string                 `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`
+	// limit limita o número de palavras de Solve (0 retorna todas)
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveRequest) Reset() {
+	*x = SolveRequest{}
+	mi := &file_wordgo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveRequest) ProtoMessage() {}
+
+func (x *SolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordgo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveRequest.ProtoReflect.Descriptor instead.
+func (*SolveRequest) Descriptor() ([]byte, []int) {
+	return file_wordgo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SolveRequest) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *SolveRequest) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Cell é uma célula com linha e coluna de base 1
+type Cell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Col           int32                  `protobuf:"varint,2,opt,name=col,proto3" json:"col,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cell) Reset() {
+	*x = Cell{}
+	mi := &file_wordgo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cell) ProtoMessage() {}
+
+func (x *Cell) ProtoReflect() protoreflect.Message {
+	mi := &file_wordgo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cell.ProtoReflect.Descriptor instead.
+func (*Cell) Descriptor() ([]byte, []int) {
+	return file_wordgo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Cell) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Cell) GetCol() int32 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+type ScoreItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Points        int32                  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreItem) Reset() {
+	*x = ScoreItem{}
+	mi := &file_wordgo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreItem) ProtoMessage() {}
+
+func (x *ScoreItem) ProtoReflect() protoreflect.Message {
+	mi := &file_wordgo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreItem.ProtoReflect.Descriptor instead.
+func (*ScoreItem) Descriptor() ([]byte, []int) {
+	return file_wordgo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScoreItem) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *ScoreItem) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type FoundWord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Path          []*Cell                `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Breakdown     []*ScoreItem           `protobuf:"bytes,4,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FoundWord) Reset() {
+	*x = FoundWord{}
+	mi := &file_wordgo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FoundWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoundWord) ProtoMessage() {}
+
+func (x *FoundWord) ProtoReflect() protoreflect.Message {
+	mi := &file_wordgo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoundWord.ProtoReflect.Descriptor instead.
+func (*FoundWord) Descriptor() ([]byte, []int) {
+	return file_wordgo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FoundWord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *FoundWord) GetPath() []*Cell {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *FoundWord) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FoundWord) GetBreakdown() []*ScoreItem {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type SolveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          int32                  `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols          int32                  `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rules         string                 `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Words         []*FoundWord           `protobuf:"bytes,5,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveResponse) Reset() {
+	*x = SolveResponse{}
+	mi := &file_wordgo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveResponse) ProtoMessage() {}
+
+func (x *SolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordgo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveResponse.ProtoReflect.Descriptor instead.
+func (*SolveResponse) Descriptor() ([]byte, []int) {
+	return file_wordgo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SolveResponse) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SolveResponse) GetCols() int32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *SolveResponse) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
+
+func (x *SolveResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SolveResponse) GetWords() []*FoundWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type PlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanRequest) Reset() {
+	*x = PlanRequest{}
+	mi := &file_wordgo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanRequest) ProtoMessage() {}
+
+func (x *PlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordgo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanRequest.ProtoReflect.Descriptor instead.
+func (*PlanRequest) Descriptor() ([]byte, []int) {
+	return file_wordgo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PlanRequest) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+type PlanResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CanClear     bool                   `protobuf:"varint,1,opt,name=can_clear,json=canClear,proto3" json:"can_clear,omitempty"`
+	MinRemaining int32                  `protobuf:"varint,2,opt,name=min_remaining,json=minRemaining,proto3" json:"min_remaining,omitempty"`
+	// moves é a sequência de jogadas na ordem em que devem ser feitas
+	Moves  []*FoundWord `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	States int32        `protobuf:"varint,4,opt,name=states,proto3" json:"states,omitempty"`
+	// stranded são as células que nunca poderão ser usadas
+	Stranded      []*Cell `protobuf:"bytes,5,rep,name=stranded,proto3" json:"stranded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResponse) Reset() {
+	*x = PlanResponse{}
+	mi := &file_wordgo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResponse) ProtoMessage() {}
+
+func (x *PlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordgo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResponse.ProtoReflect.Descriptor instead.
+func (*PlanResponse) Descriptor() ([]byte, []int) {
+	return file_wordgo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PlanResponse) GetCanClear() bool {
+	if x != nil {
+		return x.CanClear
+	}
+	return false
+}
+
+func (x *PlanResponse) GetMinRemaining() int32 {
+	if x != nil {
+		return x.MinRemaining
+	}
+	return 0
+}
+
+func (x *PlanResponse) GetMoves() []*FoundWord {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetStates() int32 {
+	if x != nil {
+		return x.States
+	}
+	return 0
+}
+
+func (x *PlanResponse) GetStranded() []*Cell {
+	if x != nil {
+		return x.Stranded
+	}
+	return nil
+}
+
+var File_wordgo_proto protoreflect.FileDescriptor
+
+const file_wordgo_proto_rawDesc = "" +
+	"\n" +
+	"\fwordgo.proto\x12\twordgo.v1\"/\n" +
+	"\x05Board\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04grid\x18\x02 \x03(\tR\x04grid\"v\n" +
+	"\fSolveRequest\x12&\n" +
+	"\x05board\x18\x01 \x01(\v2\x10.wordgo.v1.BoardR\x05board\x12\x14\n" +
+	"\x05rules\x18\x02 \x01(\tR\x05rules\x12\x12\n" +
+	"\x04game\x18\x03 \x01(\tR\x04game\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"*\n" +
+	"\x04Cell\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x10\n" +
+	"\x03col\x18\x02 \x01(\x05R\x03col\"7\n" +
+	"\tScoreItem\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06points\x18\x02 \x01(\x05R\x06points\"\x8e\x01\n" +
+	"\tFoundWord\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12#\n" +
+	"\x04path\x18\x02 \x03(\v2\x0f.wordgo.v1.CellR\x04path\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x122\n" +
+	"\tbreakdown\x18\x04 \x03(\v2\x14.wordgo.v1.ScoreItemR\tbreakdown\"\x8f\x01\n" +
+	"\rSolveResponse\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\x05R\x04rows\x12\x12\n" +
+	"\x04cols\x18\x02 \x01(\x05R\x04cols\x12\x14\n" +
+	"\x05rules\x18\x03 \x01(\tR\x05rules\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12*\n" +
+	"\x05words\x18\x05 \x03(\v2\x14.wordgo.v1.FoundWordR\x05words\"5\n" +
+	"\vPlanRequest\x12&\n" +
+	"\x05board\x18\x01 \x01(\v2\x10.wordgo.v1.BoardR\x05board\"\xc1\x01\n" +
+	"\fPlanResponse\x12\x1b\n" +
+	"\tcan_clear\x18\x01 \x01(\bR\bcanClear\x12#\n" +
+	"\rmin_remaining\x18\x02 \x01(\x05R\fminRemaining\x12*\n" +
+	"\x05moves\x18\x03 \x03(\v2\x14.wordgo.v1.FoundWordR\x05moves\x12\x16\n" +
+	"\x06states\x18\x04 \x01(\x05R\x06states\x12+\n" +
+	"\bstranded\x18\x05 \x03(\v2\x0f.wordgo.v1.CellR\bstranded2\xbd\x01\n" +
+	"\x06Solver\x12:\n" +
+	"\x05Solve\x12\x17.wordgo.v1.SolveRequest\x1a\x18.wordgo.v1.SolveResponse\x12>\n" +
+	"\vStreamSolve\x12\x17.wordgo.v1.SolveRequest\x1a\x14.wordgo.v1.FoundWord0\x01\x127\n" +
+	"\x04Plan\x12\x16.wordgo.v1.PlanRequest\x1a\x17.wordgo.v1.PlanResponseB\x11Z\x0fwordgo/wordgopbb\x06proto3"
+
+var (
+	file_wordgo_proto_rawDescOnce sync.Once
+	file_wordgo_proto_rawDescData []byte
+)
+
+func file_wordgo_proto_rawDescGZIP() []byte {
+	file_wordgo_proto_rawDescOnce.Do(func() {
+		file_wordgo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wordgo_proto_rawDesc), len(file_wordgo_proto_rawDesc)))
+	})
+	return file_wordgo_proto_rawDescData
+}
+
+var file_wordgo_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_wordgo_proto_goTypes = []any{
+	(*Board)(nil),         // 0: wordgo.v1.Board
+	(*SolveRequest)(nil),  // 1: wordgo.v1.SolveRequest
+	(*Cell)(nil),          // 2: wordgo.v1.Cell
+	(*ScoreItem)(nil),     // 3: wordgo.v1.ScoreItem
+	(*FoundWord)(nil),     // 4: wordgo.v1.FoundWord
+	(*SolveResponse)(nil), // 5: wordgo.v1.SolveResponse
+	(*PlanRequest)(nil),   // 6: wordgo.v1.PlanRequest
+	(*PlanResponse)(nil),  // 7: wordgo.v1.PlanResponse
+}
+var file_wordgo_proto_depIdxs = []int32{
+	0,  // 0: wordgo.v1.SolveRequest.board:type_name -> wordgo.v1.Board
+	2,  // 1: wordgo.v1.FoundWord.path:type_name -> wordgo.v1.Cell
+	3,  // 2: wordgo.v1.FoundWord.breakdown:type_name -> wordgo.v1.ScoreItem
+	4,  // 3: wordgo.v1.SolveResponse.words:type_name -> wordgo.v1.FoundWord
+	0,  // 4: wordgo.v1.PlanRequest.board:type_name -> wordgo.v1.Board
+	4,  // 5: wordgo.v1.PlanResponse.moves:type_name -> wordgo.v1.FoundWord
+	2,  // 6: wordgo.v1.PlanResponse.stranded:type_name -> wordgo.v1.Cell
+	1,  // 7: wordgo.v1.Solver.Solve:input_type -> wordgo.v1.SolveRequest
+	1,  // 8: wordgo.v1.Solver.StreamSolve:input_type -> wordgo.v1.SolveRequest
+	6,  // 9: wordgo.v1.Solver.Plan:input_type -> wordgo.v1.PlanRequest
+	5,  // 10: wordgo.v1.Solver.Solve:output_type -> wordgo.v1.SolveResponse
+	4,  // 11: wordgo.v1.Solver.StreamSolve:output_type -> wordgo.v1.FoundWord
+	7,  // 12: wordgo.v1.Solver.Plan:output_type -> wordgo.v1.PlanResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_wordgo_proto_init() }
+func file_wordgo_proto_init() {
+	if File_wordgo_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wordgo_proto_rawDesc), len(file_wordgo_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wordgo_proto_goTypes,
+		DependencyIndexes: file_wordgo_proto_depIdxs,
+		MessageInfos:      file_wordgo_proto_msgTypes,
+	}.Build()
+	File_wordgo_proto = out.File
+	file_wordgo_proto_goTypes = nil
+	file_wordgo_proto_depIdxs = nil
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// TargetStatus indica o resultado da busca por uma palavra da lista
+type TargetStatus int
+
+const (
+	TargetFound TargetStatus = iota
+	TargetMissing
+	TargetAmbiguous
+	// TargetInvalid marca entradas sem letras ou com caracteres que não são letras, como "123" ou "--"
+	TargetInvalid
+)
+
+func (ts TargetStatus) String() string {
+	switch ts {
+	case TargetFound:
+		return "encontrada"
+	case TargetMissing:
+		return "não encontrada"
+	case TargetAmbiguous:
+		return "ambígua"
+	case TargetInvalid:
+		return "inválida"
+	}
+	return fmt.Sprintf("TargetStatus(%d)", int(ts))
+}
+
+// WordLocation é uma ocorrência de uma palavra em linha reta
+type WordLocation struct {
+	Start     Coord
+	End       Coord
+	Direction Direction
+	Path      []Coord
+}
+
+// TargetResult é o resultado da busca de uma palavra da lista
+type TargetResult struct {
+	Word      string
+	Status    TargetStatus
+	Locations []WordLocation
+}
+
+// normalizeTarget remove espaços, hífens e apóstrofos e converte para maiúsculas
+func normalizeTarget(word string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '\'' {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, word)
+}
+
+// FindTargets localiza cada palavra da lista nas 8 direções, sem usar o dicionário.
+// Palíndromos lidos nos dois sentidos sobre as mesmas células contam como uma ocorrência.
+func (ws *WordSearcher) FindTargets(targets []string) []TargetResult {
+	matrix := ws.matrix.GetMatrix()
+	rows, cols := ws.matrix.GetDimensions()
+
+	results := make([]TargetResult, 0, len(targets))
+	for _, target := range targets {
+		word := []rune(normalizeTarget(target))
+		result := TargetResult{Word: string(word), Status: TargetMissing}
+		if len(word) == 0 || slices.ContainsFunc(word, func(r rune) bool { return !unicode.IsLetter(r) }) {
+			result.Word, result.Status = strings.TrimSpace(target), TargetInvalid
+			results = append(results, result)
+			continue
+		}
+		seen := make(map[string]bool)
+
+		for row := 0; row < rows; row++ {
+			for col := 0; col < cols; col++ {
+				if unicode.ToUpper(matrix[row][col]) != word[0] {
+					continue
+				}
+				for _, direction := range ws.directions {
+					path, ok := ws.matchLine(word, row, col, direction)
+					if !ok {
+						continue
+					}
+					cells := slices.Clone(path)
+					slices.SortFunc(cells, func(a, b Coord) int {
+						if a.X != b.X {
+							return a.X - b.X
+						}
+						return a.Y - b.Y
+					})
+					key := PathResult{Coordinates: cells}.Path()
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+					result.Locations = append(result.Locations, WordLocation{
+						Start:     path[0],
+						End:       path[len(path)-1],
+						Direction: direction,
+						Path:      path,
+					})
+				}
+			}
+		}
+
+		switch len(result.Locations) {
+		case 0:
+			result.Status = TargetMissing
+		case 1:
+			result.Status = TargetFound
+		default:
+			result.Status = TargetAmbiguous
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// matchLine verifica se a palavra pode ser lida a partir da célula na direção informada
+func (ws *WordSearcher) matchLine(word []rune, row, col int, direction Direction) ([]Coord, bool) {
+	matrix := ws.matrix.GetMatrix()
+	rows, cols := ws.matrix.GetDimensions()
+	adjacency := ws.matrix.GetAdjacency()
+	if row < 0 || row >= rows || col < 0 || col >= cols {
+		return nil, false
+	}
+	path := make([]Coord, 0, len(word))
+	cell := Coord{X: row, Y: col}
+	for i, char := range word {
+		if unicode.ToUpper(matrix[cell.X][cell.Y]) != char || containsCoord(path, cell) {
+			return nil, false
+		}
+		path = append(path, cell)
+		if i == len(word)-1 {
+			break
+		}
+		next, ok := adjacency.Step(cell, direction, rows, cols)
+		if !ok {
+			return nil, false
+		}
+		cell = next
+	}
+	return path, true
+}
+
+// RenderSolved desenha a matriz com as letras encontradas em maiúsculas e as demais como '.'
+func (ws *WordSearcher) RenderSolved(results []TargetResult) string {
+	rows, cols := ws.matrix.GetDimensions()
+	marked := newBoolGrid(rows, cols)
+	for _, result := range results {
+		for _, location := range result.Locations {
+			for _, coord := range location.Path {
+				marked[coord.X][coord.Y] = true
+			}
+		}
+	}
+
+	var sb strings.Builder
+	for i, row := range ws.matrix.GetMatrix() {
+		for j, cell := range row {
+			if j > 0 {
+				sb.WriteByte(' ')
+			}
+			if marked[i][j] {
+				sb.WriteRune(unicode.ToUpper(cell))
+			} else if cell == MASK_CELL {
+				sb.WriteRune(MASK_CELL)
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// PrintTargetResults imprime o relatório da lista de palavras
+func PrintTargetResults(w io.Writer, results []TargetResult) {
+	found, missing, ambiguous, invalid := 0, 0, 0, 0
+	for _, result := range results {
+		switch result.Status {
+		case TargetFound:
+			found++
+		case TargetMissing:
+			missing++
+		case TargetAmbiguous:
+			ambiguous++
+		case TargetInvalid:
+			invalid++
+		}
+
+		fmt.Fprintf(w, "%-16s %s", result.Word, result.Status)
+		for _, location := range result.Locations {
+			fmt.Fprintf(w, "  (%d,%d)%s(%d,%d)", location.Start.X+1, location.Start.Y+1,
+				location.Direction.Symbol, location.End.X+1, location.End.Y+1)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "\nEncontradas: %d  Ambíguas: %d  Não encontradas: %d", found, ambiguous, missing)
+	if invalid > 0 {
+		fmt.Fprintf(w, "  Inválidas: %d", invalid)
+	}
+	fmt.Fprintln(w)
+}
+
+// readTargets lê a lista de palavras de um arquivo (uma por linha) ou de uma lista separada por vírgulas
+func readTargets(spec string) ([]string, error) {
+	file, err := os.Open(spec)
+	if os.IsNotExist(err) {
+		return strings.Split(spec, ","), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s da lista de palavras: %w", ErrFileOpen, err)
+	}
+	defer file.Close()
+
+	var targets []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			targets = append(targets, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s da lista de palavras: %w", ErrFileRead, err)
+	}
+	return targets, nil
+}
+
+// runSolve implementa o subcomando "wordgo solve" (caça-palavras clássico)
+func runSolve(args []string) {
+	flags := flag.NewFlagSet("solve", flag.ExitOnError)
+	matrixFile := flags.String("matrix", "res/example_search.txt", "Arquivo de matriz de letras para carregar")
+	words := flags.String("words", "", "Arquivo com as palavras a encontrar (uma por linha) ou lista separada por vírgulas")
+	flags.Parse(args)
+
+	if *words == "" {
+		log.Fatal("Informe as palavras com -words")
+	}
+	targets, err := readTargets(*words)
+	if err != nil {
+		log.Fatalf("Erro ao carregar palavras: %v", err)
+	}
+	matrix, err := NewLetterMatrixFromFile(*matrixFile)
+	if err != nil {
+		log.Fatalf("Erro ao carregar matriz: %v", err)
+	}
+
+	searcher := NewWordSimpleSearcher(matrix, nil)
+	results := searcher.FindTargets(targets)
+	PrintTargetResults(os.Stdout, results)
+	fmt.Println()
+	fmt.Print(searcher.RenderSolved(results))
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestFindTargets tests locating a word list in all directions
+func TestFindTargets(t *testing.T) {
+	// C A T .
+	// . O . D
+	// . . G O
+	// T A C G
+	matrix, err := NewLetterMatrixFromString("catx\nxoxd\nxxgo\ntacg")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	searcher := NewWordSimpleSearcher(matrix, nil)
+	results := searcher.FindTargets([]string{"cog", "Dog", "cat", "zebra", "oo"})
+
+	expected := []struct {
+		word      string
+		status    TargetStatus
+		locations int
+	}{
+		{"COG", TargetFound, 1},
+		{"DOG", TargetFound, 1},
+		{"CAT", TargetAmbiguous, 2},
+		{"ZEBRA", TargetMissing, 0},
+		{"OO", TargetMissing, 0},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d results, got %d", len(expected), len(results))
+	}
+	for i, tc := range expected {
+		if results[i].Word != tc.word || results[i].Status != tc.status || len(results[i].Locations) != tc.locations {
+			t.Errorf("Expected %s %s with %d locations, got %+v", tc.word, tc.status, tc.locations, results[i])
+		}
+	}
+
+	cog := results[0].Locations[0]
+	if cog.Start != (Coord{0, 0}) || cog.End != (Coord{2, 2}) || cog.Direction.Name != BR {
+		t.Errorf("Unexpected COG location %+v", cog)
+	}
+	dog := results[1].Locations[0]
+	if dog.Start != (Coord{1, 3}) || dog.End != (Coord{3, 3}) || dog.Direction.Name != B {
+		t.Errorf("Unexpected DOG location %+v", dog)
+	}
+}
+
+// TestFindTargetsPalindrome tests that a palindrome counts once
+func TestFindTargetsPalindrome(t *testing.T) {
+	matrix, err := NewLetterMatrixFromString("xlevelx")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	results := NewWordSimpleSearcher(matrix, nil).FindTargets([]string{"level"})
+	if results[0].Status != TargetFound || len(results[0].Locations) != 1 {
+		t.Errorf("Expected a single LEVEL location, got %+v", results[0])
+	}
+}
+
+// TestRenderSolved tests marking found letters in the grid
+func TestRenderSolved(t *testing.T) {
+	matrix, err := NewLetterMatrixFromString("cat\nxyz")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	searcher := NewWordSimpleSearcher(matrix, nil)
+	rendered := searcher.RenderSolved(searcher.FindTargets([]string{"cat"}))
+	if rendered != "C A T\n. . .\n" {
+		t.Errorf("Unexpected rendering %q", rendered)
+	}
+}
+
+// TestFindTargetsInvalid tests that entries without usable letters are reported instead of skipped
+func TestFindTargetsInvalid(t *testing.T) {
+	matrix, err := NewLetterMatrixFromString("catx\nxoxd")
+	if err != nil {
+		t.Fatalf("Failed to create matrix: %v", err)
+	}
+	results := NewWordSimpleSearcher(matrix, nil).FindTargets([]string{"123", " -- ", "c4t", "cat"})
+
+	expected := []struct {
+		word   string
+		status TargetStatus
+	}{
+		{"123", TargetInvalid},
+		{"--", TargetInvalid},
+		{"c4t", TargetInvalid},
+		{"CAT", TargetFound},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("Expected %d results, got %v", len(expected), results)
+	}
+	for i, tc := range expected {
+		if results[i].Word != tc.word || results[i].Status != tc.status {
+			t.Errorf("Expected %s %s, got %+v", tc.word, tc.status, results[i])
+		}
+	}
+
+	var out strings.Builder
+	PrintTargetResults(&out, results)
+	if !strings.Contains(out.String(), "Inválidas: 3") {
+		t.Errorf("Expected the invalid count in the report, got %q", out.String())
+	}
+}